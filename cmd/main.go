@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/Angelmaneuver/xlsx2html/internal/kamipro"
 	"github.com/urfave/cli/v2"
 )
 
@@ -12,27 +11,97 @@ func main() {
 	app := &cli.App{
 		Name:  "excel2html",
 		Usage: "Generates HTML codes from the contents of an Excel sheets.",
+
+		DisableSliceFlagSeparator: true,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:     "input",
 				Aliases:  []string{"i"},
-				Usage:    "`Path` to the Excel file to be used for generate.",
-				Required: true,
+				Usage:    "`Path` to the Excel file, or the CSV/TSV/JSON file or directory to be used for generate. \"-\" reads from the standard input. (required)",
+				Required: false,
 			},
 			&cli.StringFlag{
 				Name:     "output",
 				Aliases:  []string{"o"},
-				Usage:    "Output `Path` for HTML to be generate.",
+				Usage:    "Output `Path` for HTML to be generate. \"-\" writes to the standard output.",
+				Required: false,
+			},
+			&cli.StringSliceFlag{
+				Name:     "dataset",
+				Aliases:  []string{"d"},
+				Usage:    "Generates only the dataset of the `Sheet`. Can be repeated.",
+				Required: false,
+			},
+			&cli.StringFlag{
+				Name:     "archive",
+				Aliases:  []string{"a"},
+				Usage:    "`Format` (tar or zip) of the archive of all outputs written to the standard output with --output -.",
+				Required: false,
+			},
+			&cli.StringFlag{
+				Name:     "config",
+				Aliases:  []string{"c"},
+				Usage:    "`Path` to the application TOML file. The embedded default is used when omitted.",
+				Required: false,
+			},
+			&cli.BoolFlag{
+				Name:     "watch",
+				Aliases:  []string{"w"},
+				Usage:    "Keeps running and generates again when the input, the configuration or the files it refers to are changed.",
 				Required: false,
 			},
+			&cli.StringSliceFlag{
+				Name:     "set",
+				Aliases:  []string{"s"},
+				Usage:    "Overrides a configuration value as `KEY=VALUE` (e.g. Html.Icon.base_url=/cdn/). Can be repeated.",
+				Required: false,
+			},
+		},
+		Commands: []*cli.Command{
+			{
+				Name:  "stylesheet",
+				Usage: "Writes the embedded default stylesheet for the generated markup.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "output",
+						Aliases:  []string{"o"},
+						Usage:    "Output `Path` for the stylesheet. Written to the standard output when omitted.",
+						Required: false,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					err := stylesheet(ctx.String("o"))
+					if err != nil {
+						return cli.Exit(err, -1)
+					}
+
+					return nil
+				},
+			},
 		},
 		Action: func(ctx *cli.Context) error {
-			err := kamipro.Start(ctx.String("i"), ctx.String("o"))
+			if !ctx.IsSet("input") {
+				cli.ShowAppHelp(ctx)
+				return cli.Exit(`Required flag "input" not set`, -1)
+			}
+
+			if ctx.Bool("w") {
+				err := watch(ctx.String("i"), ctx.String("o"), ctx.String("c"), ctx.StringSlice("s"), ctx.StringSlice("d"), ctx.String("a"))
+				if err != nil {
+					return cli.Exit(err, -1)
+				}
+
+				return nil
+			}
+
+			_, err := run(ctx.String("i"), ctx.String("o"), ctx.String("c"), ctx.StringSlice("s"), ctx.StringSlice("d"), ctx.String("a"))
 			if err != nil {
 				return cli.Exit(err, -1)
 			}
 
-			fmt.Println("Process is completed.")
+			if ctx.String("o") != standard {
+				fmt.Println("Process is completed.")
+			}
 			return nil
 		},
 	}
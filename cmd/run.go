@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Angelmaneuver/xlsx2html"
+)
+
+const standard = "-"
+
+// run returns the application it loaded, even when the generation fails afterwards.
+func run(input string, output string, config string, overrides []string, datasets []string, archive string) (*xlsx2html.Application, error) {
+	err := checkArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var sink xlsx2html.Sink = xlsx2html.Files{}
+	var buffer *xlsx2html.Buffer
+
+	switch {
+	case output == standard:
+		buffer = &xlsx2html.Buffer{}
+		sink, output = buffer, ""
+	case len(output) == 0:
+		output = directory(input)
+
+		err = os.MkdirAll(output, 0755)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	application, err := xlsx2html.LoadApplication(config, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := xlsx2html.OpenSource(input)
+	if err != nil {
+		return application, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	results, err := xlsx2html.Generate(context.Background(), xlsx2html.Options{
+		Source:      src,
+		Application: application,
+		Sink:        sink,
+		Output:      output,
+		Datasets:    datasets,
+	})
+	if err != nil {
+		return application, err
+	}
+
+	for _, warning := range results.Warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
+
+	for _, result := range results.Datasets {
+		for _, warning := range result.Warnings {
+			fmt.Fprintln(os.Stderr, warning)
+		}
+	}
+
+	if buffer != nil {
+		return application, stream(buffer.Files(), archive, os.Stdout)
+	}
+
+	return application, nil
+}
+
+func directory(input string) string {
+	if input == standard {
+		return "."
+	}
+
+	info, err := os.Stat(input)
+	if err == nil && info.IsDir() {
+		return filepath.Join(input, "output")
+	}
+
+	return filepath.Dir(input)
+}
+
+func stylesheet(output string) error {
+	text, err := xlsx2html.Stylesheet()
+	if err != nil {
+		return err
+	}
+
+	if len(output) == 0 {
+		_, err = os.Stdout.Write(text)
+		return err
+	}
+
+	return os.WriteFile(output, text, 0644)
+}
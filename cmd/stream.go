@@ -0,0 +1,96 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Angelmaneuver/xlsx2html"
+)
+
+const (
+	ArchiveTar = "tar"
+	ArchiveZip = "zip"
+)
+
+func checkArchive(archive string) error {
+	switch archive {
+	case "", ArchiveTar, ArchiveZip:
+		return nil
+	}
+
+	return fmt.Errorf("unknown archive %q, must be %s or %s", archive, ArchiveTar, ArchiveZip)
+}
+
+func stream(files []xlsx2html.File, archive string, w io.Writer) error {
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Output < files[j].Output
+	})
+
+	switch archive {
+	case "":
+		if len(files) != 1 {
+			return fmt.Errorf("%d files to write to the standard output, use --archive %s or %s", len(files), ArchiveTar, ArchiveZip)
+		}
+
+		_, err := io.WriteString(w, files[0].Text)
+		return err
+	case ArchiveTar:
+		return archiveTar(files, w)
+	case ArchiveZip:
+		return archiveZip(files, w)
+	}
+
+	return checkArchive(archive)
+}
+
+func archiveTar(files []xlsx2html.File, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	now := time.Now().Truncate(time.Second)
+
+	for _, file := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    filepath.ToSlash(file.Output),
+			Mode:    0644,
+			Size:    int64(len(file.Text)),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(tw, file.Text)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+func archiveZip(files []xlsx2html.File, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	now := time.Now().Truncate(time.Second)
+
+	for _, file := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     filepath.ToSlash(file.Output),
+			Method:   zip.Deflate,
+			Modified: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(fw, file.Text)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
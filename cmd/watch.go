@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Angelmaneuver/xlsx2html"
+)
+
+const (
+	poll     = 500 * time.Millisecond
+	debounce = time.Second
+)
+
+type stamp struct {
+	modified time.Time
+	size     int64
+}
+
+type snapshot map[string]stamp
+
+func watch(input string, output string, config string, overrides []string, datasets []string, archive string) error {
+	if input == standard || output == standard {
+		return errors.New("--watch cannot be used with the standard input or output")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var paths []string
+
+	regenerate := func() {
+		application, err := run(input, output, config, overrides, datasets, archive)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		} else {
+			fmt.Printf("Process is completed. (%s)\n", time.Now().Format("15:04:05"))
+		}
+
+		paths = watched(input, config, application, datasets)
+
+		fmt.Println("Watching for changes. Press Ctrl+C to stop.")
+	}
+
+	regenerate()
+
+	last := take(paths)
+	changed := time.Time{}
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current := take(paths)
+		if !current.equal(last) {
+			last, changed = current, time.Now()
+			continue
+		}
+
+		if changed.IsZero() || time.Since(changed) < debounce || !current.complete(input) {
+			continue
+		}
+
+		changed = time.Time{}
+		regenerate()
+
+		last = take(paths)
+	}
+}
+
+// watched lists the files to watch for the application loaded by the last run, or nil when it failed to load.
+func watched(input string, config string, application *xlsx2html.Application, datasets []string) []string {
+	var paths []string
+
+	if len(config) > 0 {
+		paths = append(paths, config)
+	}
+
+	if application == nil {
+		return append(paths, inputs(input, nil)...)
+	}
+
+	sheets := datasets
+	if len(sheets) == 0 {
+		for _, dataset := range application.Excel.Dataset {
+			sheets = append(sheets, dataset.Sheet)
+		}
+	}
+
+	paths = append(paths, inputs(input, sheets)...)
+	paths = append(paths, application.Html.Document.Styles...)
+	paths = append(paths, application.Html.Document.InlineScripts...)
+
+	return paths
+}
+
+// inputs lists the files a directory input is read from, so that the outputs written into it are not watched.
+func inputs(input string, sheets []string) []string {
+	info, err := os.Stat(input)
+	if err != nil || !info.IsDir() {
+		return []string{input}
+	}
+
+	var paths []string
+
+	for _, extension := range []string{".csv", ".tsv", ".json"} {
+		if sheets == nil {
+			found, _ := filepath.Glob(filepath.Join(input, "*"+extension))
+			paths = append(paths, found...)
+			continue
+		}
+
+		for _, sheet := range sheets {
+			paths = append(paths, filepath.Join(input, sheet+extension))
+		}
+	}
+
+	return paths
+}
+
+func take(paths []string) snapshot {
+	s := snapshot{}
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		s[path] = stamp{modified: info.ModTime(), size: info.Size()}
+	}
+
+	return s
+}
+
+func (s snapshot) equal(other snapshot) bool {
+	if len(s) != len(other) {
+		return false
+	}
+
+	for path, v := range s {
+		if w, ok := other[path]; !ok || !v.modified.Equal(w.modified) || v.size != w.size {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s snapshot) complete(input string) bool {
+	root, err := filepath.Abs(input)
+	if err != nil {
+		return false
+	}
+
+	for path := range s {
+		absolute, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+
+		relative, err := filepath.Rel(root, absolute)
+		if err == nil && relative != ".." && !strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
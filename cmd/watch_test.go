@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html"
+)
+
+func TestComplete(t *testing.T) {
+	root := t.TempDir()
+	directory := filepath.Join(root, "csv")
+
+	err := os.Mkdir(directory, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(directory, "SSR.csv")
+
+	err = os.WriteFile(file, []byte("No\n1\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"directory", directory},
+		{"trailing slash", directory + string(filepath.Separator)},
+		{"dot", filepath.Join(root, ".") + string(filepath.Separator) + "." + string(filepath.Separator) + "csv"},
+		{"file", directory + string(filepath.Separator) + "." + string(filepath.Separator) + "SSR.csv"},
+	}
+
+	for _, tt := range tests {
+		if !take(inputs(tt.input, nil)).complete(tt.input) {
+			t.Errorf("%s: %q is not complete", tt.name, tt.input)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	err = os.Chdir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range []string{".", "./", "../csv"} {
+		if !take(inputs(input, nil)).complete(input) {
+			t.Errorf("relative: %q is not complete", input)
+		}
+	}
+
+	if take([]string{file}).complete(filepath.Join(root, "other")) {
+		t.Error("a file outside the input is complete")
+	}
+}
+
+func TestWatched(t *testing.T) {
+	directory := t.TempDir()
+
+	application := &xlsx2html.Application{}
+	application.Excel.Dataset = []xlsx2html.Dataset{{Sheet: "SSR"}}
+	application.Html.Document.Styles = []string{"site.css"}
+
+	got := watched(directory, "config.toml", application, nil)
+	want := []string{
+		"config.toml",
+		filepath.Join(directory, "SSR.csv"),
+		filepath.Join(directory, "SSR.tsv"),
+		filepath.Join(directory, "SSR.json"),
+		"site.css",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = watched(directory, "config.toml", nil, nil)
+	if !reflect.DeepEqual(got, []string{"config.toml"}) {
+		t.Errorf("without an application: got %v", got)
+	}
+}
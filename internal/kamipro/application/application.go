@@ -1,8 +1,13 @@
+//go:generate go run github.com/rakyll/statik -src=. -dest=. -include=*.toml,*.css -f
+
 package application
 
 import (
+	"io"
+	"os"
+	"regexp"
+
 	_ "github.com/Angelmaneuver/xlsx2html/internal/kamipro/application/statik"
-	"github.com/BurntSushi/toml"
 	"github.com/rakyll/statik/fs"
 )
 
@@ -12,17 +17,27 @@ type Application struct {
 }
 
 type Excel struct {
-	Dataset []Dataset `toml:"dataset"`
-	Key     []string  `toml:"key"`
-	Sort    []Sort    `toml:"sort"`
-	Skip    Skip      `toml:"skip"`
+	Dataset  []Dataset           `toml:"dataset"`
+	Key      []string            `toml:"key"`
+	Sort     []Sort              `toml:"sort"`
+	Optional []string            `toml:"optional"`
+	Skip     Skip                `toml:"skip"`
+	Columns  map[string][]string `toml:"Columns"`
+	Families Families            `toml:"Families"`
+	Forms    []Form              `toml:"Forms"`
+
+	fields []string
 }
 
 type Dataset struct {
-	Sheet  string `toml:"sheet"`
-	Rarity string `toml:"rarity"`
-	Icon   string `toml:"icon"`
-	Output string `toml:"output"`
+	Sheet      string `toml:"sheet"`
+	Rarity     string `toml:"rarity"`
+	Icon       string `toml:"icon"`
+	Output     string `toml:"output"`
+	Collection string `toml:"collection"`
+	Title      string `toml:"title"`
+
+	rarities []string
 }
 
 type Sort struct {
@@ -34,46 +49,120 @@ type Skip struct {
 	Row int `toml:"row"`
 }
 
-type Html struct {
-	Headlines []string   `toml:"headlines"`
-	Icon      Icon       `toml:"icon"`
-	Threshold Thresholds `toml:"Threshold"`
-	Format    Format     `toml:"Format"`
+type Families struct {
+	Status  StatusFamily  `toml:"Status"`
+	Episode EpisodeFamily `toml:"Episode"`
+	Ability AbilityFamily `toml:"Ability"`
+}
+
+type StatusFamily struct {
+	Hp      []string `toml:"hp"`
+	Attack  []string `toml:"attack"`
+	Profile []string `toml:"profile"`
+}
+
+type EpisodeFamily struct {
+	Title    []string `toml:"title"`
+	Outline  []string `toml:"outline"`
+	Contents []string `toml:"contents"`
+	Tag      []string `toml:"tag"`
+}
+
+type AbilityFamily struct {
+	Name       []string `toml:"name"`
+	Effect     []string `toml:"effect"`
+	Interval   []string `toml:"interval"`
+	EffectTime []string `toml:"effect_time"`
+}
+
+type Form struct {
+	Name      string `toml:"name"`
+	Flag      string `toml:"flag"`
+	Episodes  int    `toml:"episodes"`
+	Abilities int    `toml:"abilities"`
+	Icon      string `toml:"icon"`
+	NoData    bool   `toml:"no_data"`
 }
 
-type Thresholds struct {
-	SSR  Threshold `toml:"ssr"`
-	SR   Threshold `toml:"sr"`
-	R    Threshold `toml:"r"`
-	Skin Threshold `toml:"skin"`
+type Html struct {
+	Headlines  []string   `toml:"headlines"`
+	Others     string     `toml:"others"`
+	Toc        bool       `toml:"toc"`
+	Index      string     `toml:"index"`
+	Exports    []string   `toml:"exports"`
+	Pagination Pagination `toml:"Pagination"`
+	Document   Document   `toml:"Document"`
+	Raw        []string   `toml:"raw"`
+	Icon       Icon       `toml:"icon"`
+	Injection  Injection  `toml:"Injection"`
+	Collection Collection `toml:"Collection"`
+	Threshold  Thresholds `toml:"Threshold"`
+	Format     Format     `toml:"Format"`
+
+	columns []string
+	forms   []string
 }
 
+type Thresholds map[string]Threshold
+
 type Threshold struct {
-	Hp     Parameter `toml:"hp"`
-	Attack Parameter `toml:"attack"`
+	Hp     Tiers `toml:"hp"`
+	Attack Tiers `toml:"attack"`
+}
+
+type Tiers []Tier
+
+type Tier struct {
+	Min    int    `toml:"min"`
+	Format string `toml:"format"`
+}
+
+type Document struct {
+	Standalone    bool     `toml:"standalone"`
+	DefaultStyle  bool     `toml:"default_style"`
+	Title         string   `toml:"title"`
+	Lang          string   `toml:"lang"`
+	Stylesheets   []string `toml:"stylesheets"`
+	Scripts       []string `toml:"scripts"`
+	Styles        []string `toml:"styles"`
+	InlineScripts []string `toml:"inline_scripts"`
+}
+
+type Pagination struct {
+	Mode string `toml:"mode"`
+	Size int    `toml:"size"`
+	Name string `toml:"name"`
+}
+
+type Collection struct {
+	Owned   string `toml:"owned"`
+	Missing string `toml:"missing"`
 }
 
-type Parameter struct {
-	High int `toml:"high"`
-	Low  int `toml:"low"`
+type Injection struct {
+	Blank []string `toml:"blank"`
 }
 
 type Icon struct {
 	BaseUrl                 string `toml:"base_url"`
-	Awaking                 string `toml:"awaking"`
-	Otherwise               string `toml:"otherwise"`
 	Extension               string `toml:"extension"`
 	NoDataDecisionCharacter string `toml:"no_data_decision_character"`
 }
 
 type Format struct {
-	Start     string              `toml:"start"`
-	Close     string              `toml:"close"`
-	Headline  string              `toml:"headline"`
-	Article   Article             `toml:"Article"`
-	Attribute Attribute           `toml:"Attribute"`
-	Type      Type                `toml:"Type"`
-	Threshold FormatWithThreshold `toml:"threshold"`
+	Syntax     string            `toml:"syntax"`
+	Start      string            `toml:"start"`
+	Close      string            `toml:"close"`
+	Headline   string            `toml:"headline"`
+	Article    Article           `toml:"Article"`
+	Attribute  map[string]string `toml:"Attribute"`
+	Type       map[string]string `toml:"Type"`
+	Threshold  map[string]string `toml:"threshold"`
+	Badge      Badge             `toml:"Badge"`
+	Toc        Toc               `toml:"Toc"`
+	Index      Index             `toml:"Index"`
+	Pagination FormatPagination  `toml:"Pagination"`
+	Document   FormatDocument    `toml:"Document"`
 }
 
 type Article struct {
@@ -82,36 +171,18 @@ type Article struct {
 	Main  Main   `toml:"Main"`
 }
 
-type Attribute struct {
-	Fire     string `toml:"fire"`
-	Water    string `toml:"water"`
-	Wind     string `toml:"wind"`
-	Thunder  string `toml:"thunder"`
-	Light    string `toml:"light"`
-	Darkness string `toml:"darkness"`
-}
-
-type Type struct {
-	Attack  string `toml:"attack"`
-	Defense string `toml:"defense"`
-	Tricky  string `toml:"tricky"`
-	Balance string `toml:"balance"`
-	Healer  string `toml:"healer"`
-}
-
 type Main struct {
-	Start   string  `toml:"start"`
-	Close   string  `toml:"close"`
-	Ribbon1 string  `toml:"ribbon1"`
-	Ribbon2 string  `toml:"ribbon2"`
-	Ribbon3 string  `toml:"ribbon3"`
-	Profile Profile `toml:"Profile"`
+	Start   string            `toml:"start"`
+	Close   string            `toml:"close"`
+	Ribbon  map[string]string `toml:"Ribbon"`
+	Profile Profile           `toml:"Profile"`
 }
 
 type Profile struct {
 	Start   string  `toml:"start"`
 	Close   string  `toml:"close"`
 	Detail  Detail  `toml:"Detail"`
+	Ability Ability `toml:"Ability"`
 	Episode Episode `toml:"Episode"`
 }
 
@@ -130,37 +201,178 @@ type Personal struct {
 	Profile string `toml:"profile"`
 }
 
+type Ability struct {
+	Start   string `toml:"start"`
+	Close   string `toml:"close"`
+	Content string `toml:"content"`
+}
+
 type Episode struct {
 	Start   string `toml:"start"`
 	Close   string `toml:"close"`
 	Content string `toml:"content"`
 }
 
-type FormatWithThreshold struct {
-	Higher string `toml:"higher"`
-	Lower  string `toml:"lower"`
+type Toc struct {
+	Start string `toml:"start"`
+	Close string `toml:"close"`
+	Item  string `toml:"item"`
+}
+
+type Index struct {
+	Start string `toml:"start"`
+	Close string `toml:"close"`
+	Item  string `toml:"item"`
 }
 
-func New() (*Application, error) {
+type FormatDocument struct {
+	Start string `toml:"start"`
+	Close string `toml:"close"`
+}
+
+type FormatPagination struct {
+	Start    string `toml:"start"`
+	Close    string `toml:"close"`
+	Previous string `toml:"previous"`
+	Next     string `toml:"next"`
+}
+
+type Badge struct {
+	Owned   string `toml:"owned"`
+	Missing string `toml:"missing"`
+}
+
+var rarity = regexp.MustCompile(`^Excel\.dataset\[\d+\]\.rarity$`)
+
+func New(path string, expressions []string, fields []string) (*Application, error) {
 	var application Application
 
-	statikFS, err := fs.New()
+	values, found, err := load("")
 	if err != nil {
 		return nil, err
 	}
 
-	r, err := statikFS.Open("/application.toml")
+	if len(path) > 0 {
+		file, fileFound, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		values.merge(file)
+		found.merge(fileFound)
+	}
+
+	environment, environmentFound := values.environment()
+	values.merge(environment)
+	found.merge(environmentFound)
+
+	sets, setsFound, err := overrides(expressions)
 	if err != nil {
 		return nil, err
 	}
+
+	values.merge(sets)
+	found.merge(setsFound)
+	values.legacy()
+
+	var result Problems
+
+	unused, err := values.decode(&application)
+	if err != nil {
+		result, err = mismatches(err, found)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	application.prepare(fields)
+
+	for _, path := range unused {
+		result = append(result, Problem{Path: path, Message: "unknown key", Origin: found.lookup(path)})
+	}
+
+	decoded := result
+	thresholds := decoded.within("Html.Threshold")
+
+	err = application.Validate()
+	if err != nil {
+		for _, problem := range problems(err, found) {
+			if decoded.covers(problem.Path) || decoded.within(problem.Path) || thresholds && rarity.MatchString(problem.Path) {
+				continue
+			}
+
+			result = append(result, problem)
+		}
+	}
+
+	if len(result) > 0 {
+		result.sort()
+		return nil, result
+	}
+
+	return &application, nil
+}
+
+func (a Application) Prepare(fields []string) (*Application, error) {
+	a.prepare(fields)
+
+	err := a.Validate()
+	if err != nil {
+		result := problems(err, origins{})
+		result.sort()
+
+		return nil, result
+	}
+
+	return &a, nil
+}
+
+func (a *Application) prepare(fields []string) {
+	datasets := make([]Dataset, len(a.Excel.Dataset))
+	copy(datasets, a.Excel.Dataset)
+
+	for i := range datasets {
+		datasets[i].rarities = a.Html.Threshold.Rarities()
+	}
+
+	a.Excel.Dataset = datasets
+	a.Excel.fields = fields
+	a.Html.columns = a.Excel.Headers()
+	a.Html.forms = a.Excel.FormNames()
+}
+
+func load(path string) (layer, origins, error) {
+	r, err := open(path)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer r.Close()
 
-	decoder := toml.NewDecoder(r)
+	if len(path) == 0 {
+		return read("application.toml (embedded)", r)
+	}
+
+	return read(path, r)
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if len(path) > 0 {
+		return os.Open(path)
+	}
 
-	_, err = decoder.Decode(&application)
+	statikFS, err := fs.New()
 	if err != nil {
 		return nil, err
 	}
 
-	return &application, nil
+	return statikFS.Open("/application.toml")
+}
+
+func Stylesheet() ([]byte, error) {
+	statikFS, err := fs.New()
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.ReadFile(statikFS, "/default.css")
 }
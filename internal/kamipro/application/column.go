@@ -0,0 +1,180 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	CollectionAll     = "all"
+	CollectionOwned   = "owned"
+	CollectionMissing = "missing"
+	CollectionMark    = "mark"
+
+	PaginationNone     = "none"
+	PaginationCount    = "count"
+	PaginationHeadline = "headline"
+
+	ExportJson     = "json"
+	ExportMarkdown = "markdown"
+)
+
+func (e Excel) Headers() []string {
+	var headers []string
+
+	for _, field := range e.Fields() {
+		headers = append(headers, e.Columns[field]...)
+	}
+
+	for _, patterns := range e.Families.Patterns() {
+		headers = append(headers, patterns...)
+	}
+
+	return headers
+}
+
+func (e Excel) Fields() []string {
+	fields := make([]string, 0, len(e.Columns))
+	for field := range e.Columns {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+func (e Excel) Primary(header string) (string, bool) {
+	for _, aliases := range e.Columns {
+		for _, alias := range aliases {
+			if alias == header {
+				return aliases[0], true
+			}
+		}
+	}
+
+	for _, patterns := range e.Families.Patterns() {
+		for _, pattern := range patterns {
+			if pattern == header {
+				return patterns[0], true
+			}
+
+			if number, ok := Match(pattern, header); ok {
+				return fmt.Sprintf(patterns[0], number), true
+			}
+		}
+	}
+
+	return header, false
+}
+
+func (e Excel) Field(header string) (string, bool) {
+	for field, aliases := range e.Columns {
+		for _, alias := range aliases {
+			if alias == header {
+				return field, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (e Excel) IsOptional(field string) bool {
+	for _, v := range e.Optional {
+		if v == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e Excel) IsUsed(field string) bool {
+	if len(e.fields) == 0 {
+		return true
+	}
+
+	for _, v := range e.fields {
+		if v == field {
+			return true
+		}
+	}
+
+	for _, form := range e.Forms {
+		if form.Flag == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e Excel) FormNames() []string {
+	names := make([]string, len(e.Forms))
+	for i, form := range e.Forms {
+		names[i] = form.Name
+	}
+
+	return names
+}
+
+func (f Families) Patterns() [][]string {
+	return [][]string{
+		f.Status.Hp,
+		f.Status.Attack,
+		f.Status.Profile,
+		f.Episode.Title,
+		f.Episode.Outline,
+		f.Episode.Contents,
+		f.Episode.Tag,
+		f.Ability.Name,
+		f.Ability.Effect,
+		f.Ability.Interval,
+		f.Ability.EffectTime,
+	}
+}
+
+func Match(pattern string, header string) (int, bool) {
+	prefix, suffix, ok := strings.Cut(pattern, "%d")
+	if !ok || len(prefix)+len(suffix) >= len(header) {
+		return 0, false
+	}
+
+	if !strings.HasPrefix(header, prefix) || !strings.HasSuffix(header, suffix) {
+		return 0, false
+	}
+
+	digits := header[len(prefix) : len(header)-len(suffix)]
+	if strings.Trim(digits, "0123456789") != "" {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(digits)
+	if err != nil || number < 1 {
+		return 0, false
+	}
+
+	return number, true
+}
+
+func (t Thresholds) Rarities() []string {
+	rarities := make([]string, 0, len(t))
+	for rarity := range t {
+		rarities = append(rarities, rarity)
+	}
+	sort.Strings(rarities)
+
+	return rarities
+}
+
+func (t Thresholds) Lookup(rarity string) (Threshold, bool) {
+	for k, v := range t {
+		if strings.EqualFold(k, rarity) {
+			return v, true
+		}
+	}
+
+	return Threshold{}, false
+}
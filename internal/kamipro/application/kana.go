@@ -0,0 +1,82 @@
+package application
+
+import (
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+var Syllabary = []string{"あ", "か", "さ", "た", "な", "は", "ま", "や", "ら", "わ"}
+
+var anchors = []string{"a", "ka", "sa", "ta", "na", "ha", "ma", "ya", "ra", "wa"}
+
+var rows = []string{
+	"あいうえおぁぃぅぇぉゔ",
+	"かきくけこがぎぐげごゕゖ",
+	"さしすせそざじずぜぞ",
+	"たちつてとだぢづでどっ",
+	"なにぬねの",
+	"はひふへほばびぶべぼぱぴぷぺぽ",
+	"まみむめも",
+	"やゆよゃゅょ",
+	"らりるれろ",
+	"わゐゑをんゎ",
+}
+
+func Hiragana(r rune) rune {
+	r = []rune(width.Widen.String(string(r)))[0]
+
+	if (r >= 'ァ' && r <= 'ヶ') || r == 'ヽ' || r == 'ヾ' {
+		return r - 'ァ' + 'ぁ'
+	}
+
+	if r >= 'ヷ' && r <= 'ヺ' {
+		return []rune("わゐゑを")[r-'ヷ']
+	}
+
+	return r
+}
+
+func Row(reading string) (int, bool) {
+	reading = strings.TrimSpace(reading)
+	if len(reading) == 0 {
+		return 0, false
+	}
+
+	r := Hiragana([]rune(reading)[0])
+
+	for i, row := range rows {
+		if strings.ContainsRune(row, r) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func Headline(headlines []string, reading string) (int, bool) {
+	row, ok := Row(reading)
+	if !ok {
+		return len(headlines), false
+	}
+
+	index, found := len(headlines), false
+
+	for i, headline := range headlines {
+		if v, _ := Row(headline); v <= row {
+			index, found = i, true
+		}
+	}
+
+	return index, found
+}
+
+func Anchor(headline string) string {
+	for i, v := range Syllabary {
+		if v == headline {
+			return "headline-" + anchors[i]
+		}
+	}
+
+	return "headline-others"
+}
@@ -0,0 +1,95 @@
+package application
+
+import (
+	"testing"
+)
+
+func TestRow(t *testing.T) {
+	tests := []struct {
+		reading string
+		want    int
+		found   bool
+	}{
+		{"あいか", 0, true},
+		{"アイカ", 0, true},
+		{"ｱｲｶ", 0, true},
+		{"ぁ", 0, true},
+		{"ヴァルキリー", 0, true},
+		{"ｳﾞｧﾙｷﾘｰ", 0, true},
+		{"ゔ", 0, true},
+		{"がぶりえる", 1, true},
+		{"ガブリエル", 1, true},
+		{"ｶﾞﾌﾞﾘｴﾙ", 1, true},
+		{"ヶ", 1, true},
+		{"ジャンヌ", 2, true},
+		{"ッ", 3, true},
+		{"ｯ", 3, true},
+		{"ヂ", 3, true},
+		{"ぱんどら", 5, true},
+		{"パンドラ", 5, true},
+		{"ﾊﾟﾝﾄﾞﾗ", 5, true},
+		{"ﾎﾞ", 5, true},
+		{"ゃ", 7, true},
+		{"ヷ", 9, true},
+		{"ヺ", 9, true},
+		{"ン", 9, true},
+		{"  わ", 9, true},
+		{"", 0, false},
+		{"ー", 0, false},
+		{"ﾞ", 0, false},
+		{"Zeus", 0, false},
+		{"Ｚｅｕｓ", 0, false},
+		{"神", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, found := Row(tt.reading)
+		if got != tt.want || found != tt.found {
+			t.Errorf("%q: got %d, %t, want %d, %t", tt.reading, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestHeadline(t *testing.T) {
+	tests := []struct {
+		headlines []string
+		reading   string
+		want      int
+		found     bool
+	}{
+		{Syllabary, "ぜうす", 2, true},
+		{Syllabary, "ｾﾞｳｽ", 2, true},
+		{Syllabary, "ポセイドン", 5, true},
+		{[]string{"あ", "さ", "は"}, "ガブリエル", 0, true},
+		{[]string{"あ", "さ", "は"}, "ﾃﾞｨｵﾆｭｿｽ", 1, true},
+		{[]string{"あ", "さ", "は"}, "ヷ", 2, true},
+		{[]string{"か", "さ"}, "アテナ", 2, false},
+		{[]string{"か", "さ"}, "Zeus", 2, false},
+		{nil, "あ", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, found := Headline(tt.headlines, tt.reading)
+		if got != tt.want || found != tt.found {
+			t.Errorf("%v %q: got %d, %t, want %d, %t", tt.headlines, tt.reading, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	tests := map[string]string{
+		"あ":   "headline-a",
+		"か":   "headline-ka",
+		"は":   "headline-ha",
+		"わ":   "headline-wa",
+		"その他": "headline-others",
+		"":    "headline-others",
+		"ア":   "headline-others",
+	}
+
+	for headline, want := range tests {
+		if got := Anchor(headline); got != want {
+			t.Errorf("%q: got %q, want %q", headline, got, want)
+		}
+	}
+}
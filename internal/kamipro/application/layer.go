@@ -0,0 +1,417 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mitchellh/mapstructure"
+)
+
+const EnvironmentPrefix = "XLSX2HTML_"
+
+type layer map[string]interface{}
+
+type literal struct {
+	text  string
+	value interface{}
+}
+
+func read(source string, r io.Reader) (layer, origins, error) {
+	values := layer{}
+
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = toml.Decode(string(text), (*map[string]interface{})(&values))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	return values, locate(source, string(text)), nil
+}
+
+func (l layer) merge(src layer) {
+	for k, v := range src {
+		key := l.key(k)
+
+		dst, isDstMap := l[key].(map[string]interface{})
+		values, isSrcMap := v.(map[string]interface{})
+
+		if isDstMap && isSrcMap {
+			layer(dst).merge(values)
+		} else {
+			l[key] = v
+		}
+	}
+}
+
+func (l layer) key(name string) string {
+	if _, ok := l[name]; ok {
+		return name
+	}
+
+	for k := range l {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+
+	return name
+}
+
+func (l layer) set(path []string, value interface{}) {
+	current := l
+
+	for _, name := range path[:len(path)-1] {
+		key := current.key(name)
+
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+
+		current = next
+	}
+
+	current[current.key(path[len(path)-1])] = value
+}
+
+func (l layer) table(path ...string) layer {
+	current := l
+
+	for _, name := range path {
+		next, ok := current[current.key(name)].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		current = next
+	}
+
+	return current
+}
+
+// legacy rewrites the keys of earlier versions (high/low thresholds, ribbon1..3 and the icon suffixes of
+// Html.icon) into the current ones.
+func (l layer) legacy() {
+	for _, v := range l.table("Html", "Threshold") {
+		threshold, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for stat, value := range threshold {
+			if pair, ok := value.(map[string]interface{}); ok {
+				threshold[stat] = bands(pair)
+			}
+		}
+	}
+
+	forms := tables(l.table("Excel")[l.table("Excel").key("Forms")])
+
+	if main := l.table("Html", "Format", "Article", "Main"); main != nil {
+		for key, value := range main {
+			match := ribbon.FindStringSubmatch(key)
+			if match == nil {
+				continue
+			}
+
+			n, _ := strconv.Atoi(match[1])
+			if n < 1 || n > len(forms) {
+				continue
+			}
+
+			ribbons, ok := main[main.key("Ribbon")].(map[string]interface{})
+			if !ok {
+				ribbons = map[string]interface{}{}
+				main[main.key("Ribbon")] = ribbons
+			}
+
+			ribbons[fmt.Sprint(forms[n-1][layer(forms[n-1]).key("name")])] = value
+			delete(main, key)
+		}
+	}
+
+	if icon := l.table("Html", "icon"); icon != nil {
+		for key, value := range icon {
+			for _, form := range forms {
+				if name, _ := form[layer(form).key("name")].(string); strings.EqualFold(name, key) {
+					form[layer(form).key("icon")] = value
+					delete(icon, key)
+				}
+			}
+		}
+	}
+}
+
+func bands(pair map[string]interface{}) interface{} {
+	high, isHigh := integer(pair[layer(pair).key("high")])
+	low, isLow := integer(pair[layer(pair).key("low")])
+	if !isHigh || !isLow || len(pair) != 2 {
+		return pair
+	}
+
+	tiers := []interface{}{map[string]interface{}{"min": high, "format": "higher"}}
+	if low+1 < high {
+		tiers = append(tiers, map[string]interface{}{"min": low + 1})
+	}
+
+	if last := tiers[len(tiers)-1].(map[string]interface{})["min"].(int64); last > 0 {
+		tiers = append(tiers, map[string]interface{}{"min": int64(0), "format": "lower"})
+	}
+
+	return tiers
+}
+
+func integer(v interface{}) (int64, bool) {
+	if l, ok := v.(literal); ok {
+		v = l.value
+	}
+
+	n, ok := v.(int64)
+
+	return n, ok
+}
+
+func tables(v interface{}) []map[string]interface{} {
+	switch values := v.(type) {
+	case []map[string]interface{}:
+		return values
+	case []interface{}:
+		var result []map[string]interface{}
+		for _, value := range values {
+			if table, ok := value.(map[string]interface{}); ok {
+				result = append(result, table)
+			}
+		}
+
+		return result
+	}
+
+	return nil
+}
+
+func (l layer) leaves(prefix []string, fn func(path []string)) {
+	keys := make([]string, 0, len(l))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		path := append(append([]string{}, prefix...), k)
+
+		if values, ok := l[k].(map[string]interface{}); ok {
+			layer(values).leaves(path, fn)
+		} else {
+			fn(path)
+		}
+	}
+}
+
+func (l layer) environment() (layer, origins) {
+	values := layer{}
+	found := origins{}
+
+	l.leaves(nil, func(path []string) {
+		name := EnvironmentPrefix + strings.ToUpper(strings.Join(path, "_"))
+
+		v, ok := os.LookupEnv(name)
+		if ok {
+			value := parse(v)
+			values.set(path, value)
+			found.set(path, value, "environment variable "+name)
+		}
+	})
+
+	return values, found
+}
+
+func overrides(expressions []string) (layer, origins, error) {
+	values := layer{}
+	found := origins{}
+
+	for _, expression := range expressions {
+		path, v, ok := strings.Cut(expression, "=")
+		if !ok || len(strings.TrimSpace(path)) == 0 {
+			return nil, nil, fmt.Errorf("invalid override %q, expected KEY=VALUE", expression)
+		}
+
+		keys := strings.Split(strings.TrimSpace(path), ".")
+		for _, k := range keys {
+			if len(k) == 0 {
+				return nil, nil, fmt.Errorf("invalid override %q, empty key", expression)
+			}
+		}
+
+		value := parse(v)
+		values.set(keys, value)
+		found.set(keys, value, "--set "+expression)
+	}
+
+	return values, found, nil
+}
+
+func parse(v string) interface{} {
+	var values map[string]interface{}
+
+	_, err := toml.Decode("v = "+v, &values)
+	if err != nil {
+		return v
+	}
+
+	switch value := values["v"].(type) {
+	case string, []interface{}, map[string]interface{}:
+		return value
+	default:
+		return literal{text: v, value: value}
+	}
+}
+
+func unquote(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	l, ok := data.(literal)
+	if !ok {
+		return data, nil
+	}
+
+	if to.Kind() == reflect.String {
+		return strings.TrimSpace(l.text), nil
+	}
+
+	return l.value, nil
+}
+
+func (l layer) decode(application *Application) ([]string, error) {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:    "toml",
+		Result:     application,
+		DecodeHook: unquote,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+
+	unused("", map[string]interface{}(l), reflect.TypeOf(application).Elem(), func(path string) {
+		keys = append(keys, path)
+	})
+
+	return keys, decoder.Decode(map[string]interface{}(l))
+}
+
+// unused walks the values like the decoder does, so that unknown keys are found even when decoding fails.
+func unused(prefix string, value interface{}, t reflect.Type, fn func(path string)) {
+	name := func(key string) string {
+		if len(prefix) == 0 {
+			return key
+		}
+
+		return prefix + "." + key
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch t.Kind() {
+	case reflect.Struct:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			field, ok := fieldOf(t, k)
+			if !ok {
+				fn(name(k))
+				continue
+			}
+
+			unused(name(strings.Split(field.Tag.Get("toml"), ",")[0]), values[k], field.Type, fn)
+		}
+	case reflect.Map:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for k, item := range values {
+			unused(name(k), item, t.Elem(), fn)
+		}
+	case reflect.Slice:
+		if v.Kind() != reflect.Slice {
+			return
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			unused(fmt.Sprintf("%s[%d]", prefix, i), v.Index(i).Interface(), t.Elem(), fn)
+		}
+	}
+}
+
+func fieldOf(t reflect.Type, key string) (reflect.StructField, bool) {
+	var found *reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := strings.Split(field.Tag.Get("toml"), ",")[0]
+		if !field.IsExported() || len(tag) == 0 {
+			continue
+		}
+
+		if tag == key {
+			return field, true
+		}
+
+		if found == nil && strings.EqualFold(tag, key) {
+			found = &field
+		}
+	}
+
+	if found == nil {
+		return reflect.StructField{}, false
+	}
+
+	return *found, true
+}
+
+var ribbon = regexp.MustCompile(`^(?i)ribbon(\d+)$`)
+var mismatch = regexp.MustCompile(`^'([^']*)':? (.*)$`)
+var named = regexp.MustCompile(`\[([^\]]*[^0-9\]][^\]]*)\]`)
+
+func mismatches(err error, found origins) (Problems, error) {
+	decoding, ok := err.(*mapstructure.Error)
+	if !ok {
+		return nil, err
+	}
+
+	var result Problems
+
+	for _, text := range decoding.Errors {
+		path, message := "", text
+
+		if match := mismatch.FindStringSubmatch(text); match != nil {
+			path, message = named.ReplaceAllString(match[1], ".$1"), match[2]
+		}
+
+		result = append(result, Problem{Path: path, Message: message, Origin: found.lookup(path)})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package application
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stage.toml")
+
+	err := os.WriteFile(path, []byte("[Excel]\n\t[Excel.Skip]\n\t\trow = \"3\"\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = New(path, []string{"Html.toc=yes", "Html.Bogus=1", "Html.Threshold.ssr.hp=5"}, nil)
+
+	var result Problems
+	if !errors.As(err, &result) {
+		t.Fatalf("got %v, want Problems", err)
+	}
+
+	want := map[string]string{
+		"Excel.skip.row":        path + ":3",
+		"Html.toc":              "--set Html.toc=yes",
+		"Html.Bogus":            "--set Html.Bogus=1",
+		"Html.Threshold.ssr.hp": "--set Html.Threshold.ssr.hp=5",
+	}
+
+	for _, problem := range result {
+		if rarity.MatchString(problem.Path) || problem.Path == "Html.Threshold" {
+			t.Errorf("%s: reported after a failed Html.Threshold decode: %s", problem.Path, problem.Message)
+		}
+
+		if origin, ok := want[problem.Path]; ok {
+			if problem.Origin.String() != origin {
+				t.Errorf("%s: got %s, want %s", problem.Path, problem.Origin, origin)
+			}
+			delete(want, problem.Path)
+		}
+	}
+
+	for path := range want {
+		t.Errorf("%s: not reported in %v", path, result)
+	}
+}
+
+func TestNewLegacy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "legacy.toml")
+
+	text := `[Html]
+	[Html.Icon]
+		awaking   = "x"
+		otherwise = "y"
+
+	[Html.Threshold]
+		ssr  = { hp = { high = 1700, low = 1499 }, attack = { high = 8500, low = 6999 } }
+		sr   = { hp = { high = 1300, low = 999  }, attack = { high = 7000, low = 5999 } }
+		r    = { hp = { high = 800,  low = 699  }, attack = { high = 4500, low = 3999 } }
+		skin = { hp = { high = 0,    low = 0    }, attack = { high = 0,    low = 0    } }
+
+	[Html.Format.Article.Main]
+		ribbon1 = "<div>1</div>"
+		ribbon2 = "<div>2</div>"
+		ribbon3 = "<div>3</div>"
+`
+
+	err := os.WriteFile(path, []byte(text), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	application, err := New(path, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ssr, _ := application.Html.Threshold.Lookup("SSR")
+	if want := (Tiers{{Min: 1700, Format: "higher"}, {Min: 1500}, {Min: 0, Format: "lower"}}); !reflect.DeepEqual(ssr.Hp, want) {
+		t.Errorf("ssr.hp: got %v, want %v", ssr.Hp, want)
+	}
+
+	skin, _ := application.Html.Threshold.Lookup("Skin")
+	if want := (Tiers{{Min: 0, Format: "higher"}}); !reflect.DeepEqual(skin.Attack, want) {
+		t.Errorf("skin.attack: got %v, want %v", skin.Attack, want)
+	}
+
+	for name, want := range map[string]string{"Normal": "<div>1</div>", "Awaking": "<div>2</div>", "Otherwise": "<div>3</div>"} {
+		if got := application.Html.Format.Article.Main.Ribbon[name]; got != want {
+			t.Errorf("Ribbon.%s: got %q, want %q", name, got, want)
+		}
+	}
+
+	for _, form := range application.Excel.Forms {
+		if want := map[string]string{"Normal": "", "Awaking": "x", "Otherwise": "y"}[form.Name]; form.Icon != want {
+			t.Errorf("%s: got icon %q, want %q", form.Name, form.Icon, want)
+		}
+	}
+}
+
+func TestNewRarity(t *testing.T) {
+	dataset := `Excel.dataset=[{ sheet = "UR神姫リスト", rarity = "ur", icon = "UR%03d", output = "UR神姫リスト.html" }]`
+	threshold := `Html.Threshold.UR={ hp = [{ min = 2000, format = "higher" }, { min = 0 }], attack = [{ min = 0 }] }`
+
+	application, err := New("", []string{dataset, threshold}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := application.Html.Threshold.Lookup(application.Excel.Dataset[0].Rarity); !ok {
+		t.Errorf("no threshold for %s in %v", application.Excel.Dataset[0].Rarity, application.Html.Threshold.Rarities())
+	}
+
+	_, err = New("", []string{strings.Replace(dataset, `"ur"`, `"LR"`, 1)}, nil)
+
+	var result Problems
+	if !errors.As(err, &result) || len(result) != 1 || !strings.Contains(result[0].Message, "must be one of r, skin, sr, ssr") {
+		t.Errorf("got %v, want a problem listing the rarities", err)
+	}
+}
@@ -0,0 +1,320 @@
+package application
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Origin struct {
+	Source string
+	Line   int
+}
+
+func (o Origin) String() string {
+	if o.Line > 0 {
+		return fmt.Sprintf("%s:%d", o.Source, o.Line)
+	}
+
+	return o.Source
+}
+
+type origin struct {
+	Origin
+	array bool
+}
+
+type origins map[string]origin
+
+func (o origins) merge(src origins) {
+	for path, v := range src {
+		if !v.array {
+			continue
+		}
+
+		for k := range o {
+			if strings.HasPrefix(k, path+".") || strings.HasPrefix(k, path+"[") {
+				delete(o, k)
+			}
+		}
+	}
+
+	for path, v := range src {
+		o[path] = v
+	}
+}
+
+func (o origins) lookup(path string) Origin {
+	key := strings.ToLower(path)
+
+	for len(key) > 0 {
+		if v, ok := o[key]; ok {
+			return v.Origin
+		}
+
+		i := strings.LastIndexAny(key, ".[")
+		if i < 0 {
+			break
+		}
+
+		key = key[:i]
+	}
+
+	return Origin{}
+}
+
+func (o origins) set(path []string, value interface{}, source string) {
+	_, array := value.([]interface{})
+	o[strings.ToLower(strings.Join(path, "."))] = origin{Origin: Origin{Source: source}, array: array}
+}
+
+type locator struct {
+	source string
+	text   string
+	pos    int
+	line   int
+	found  origins
+	tables map[string]int
+}
+
+func locate(source string, text string) origins {
+	l := locator{
+		source: source,
+		text:   text,
+		line:   1,
+		found:  origins{},
+		tables: map[string]int{},
+	}
+
+	table := ""
+
+	for {
+		l.skip(true)
+		if l.eof() {
+			break
+		}
+
+		if l.peek() == '[' {
+			table = l.header()
+		} else {
+			l.keyValue(table)
+		}
+	}
+
+	return l.found
+}
+
+func (l *locator) eof() bool {
+	return l.pos >= len(l.text)
+}
+
+func (l *locator) peek() byte {
+	if l.eof() {
+		return 0
+	}
+
+	return l.text[l.pos]
+}
+
+func (l *locator) next() {
+	if l.peek() == '\n' {
+		l.line++
+	}
+
+	l.pos++
+}
+
+func (l *locator) skip(newline bool) {
+	for !l.eof() {
+		switch l.peek() {
+		case ' ', '\t', '\r':
+			l.next()
+		case '\n':
+			if !newline {
+				return
+			}
+			l.next()
+		case '#':
+			for !l.eof() && l.peek() != '\n' {
+				l.next()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *locator) record(path string, array bool) {
+	l.found[path] = origin{Origin: Origin{Source: l.source, Line: l.line}, array: array}
+}
+
+func (l *locator) header() string {
+	l.next()
+
+	double := l.peek() == '['
+	if double {
+		l.next()
+	}
+
+	l.skip(false)
+	path := join("", l.key())
+
+	for !l.eof() && l.peek() != '\n' && l.peek() != '#' {
+		l.next()
+	}
+
+	if !double {
+		l.record(path, false)
+		return path
+	}
+
+	index := l.tables[path]
+	l.tables[path]++
+
+	if index == 0 {
+		l.record(path, true)
+	}
+
+	element := path + "[" + strconv.Itoa(index) + "]"
+	l.record(element, false)
+
+	return element
+}
+
+func (l *locator) key() []string {
+	var keys []string
+
+	for !l.eof() {
+		l.skip(false)
+
+		switch l.peek() {
+		case '"', '\'':
+			start := l.pos + 1
+			l.str()
+			keys = append(keys, strings.ToLower(l.text[start:l.pos-1]))
+		default:
+			start := l.pos
+			for !l.eof() && strings.IndexByte(" \t=.]\n", l.peek()) < 0 {
+				l.next()
+			}
+			keys = append(keys, strings.ToLower(l.text[start:l.pos]))
+		}
+
+		l.skip(false)
+		if l.peek() != '.' {
+			break
+		}
+		l.next()
+	}
+
+	return keys
+}
+
+func (l *locator) keyValue(prefix string) {
+	line := l.line
+	path := join(prefix, l.key())
+
+	l.skip(false)
+	if l.peek() != '=' {
+		for !l.eof() && l.peek() != '\n' {
+			l.next()
+		}
+		return
+	}
+	l.next()
+	l.skip(false)
+
+	array := l.peek() == '['
+	l.found[path] = origin{Origin: Origin{Source: l.source, Line: line}, array: array}
+
+	l.value(path)
+}
+
+func (l *locator) value(path string) {
+	switch l.peek() {
+	case '{':
+		l.next()
+
+		for !l.eof() {
+			l.skip(true)
+
+			if l.peek() == '}' {
+				l.next()
+				return
+			}
+
+			l.keyValue(path)
+			l.skip(true)
+
+			if l.peek() == ',' {
+				l.next()
+			}
+		}
+	case '[':
+		l.next()
+
+		for index := 0; !l.eof(); index++ {
+			l.skip(true)
+
+			if l.peek() == ']' {
+				l.next()
+				return
+			}
+
+			element := path + "[" + strconv.Itoa(index) + "]"
+			l.record(element, l.peek() == '[')
+			l.value(element)
+			l.skip(true)
+
+			if l.peek() == ',' {
+				l.next()
+			}
+		}
+	case '"', '\'':
+		l.str()
+	default:
+		for !l.eof() && strings.IndexByte(",]}\n#", l.peek()) < 0 {
+			l.next()
+		}
+	}
+}
+
+func (l *locator) str() {
+	quote := l.peek()
+	delimiter := string(quote)
+
+	if strings.HasPrefix(l.text[l.pos:], strings.Repeat(delimiter, 3)) {
+		delimiter = strings.Repeat(delimiter, 3)
+	}
+
+	for i := 0; i < len(delimiter); i++ {
+		l.next()
+	}
+
+	for !l.eof() {
+		if quote == '"' && l.peek() == '\\' {
+			l.next()
+			l.next()
+			continue
+		}
+
+		if strings.HasPrefix(l.text[l.pos:], delimiter) {
+			for i := 0; i < len(delimiter); i++ {
+				l.next()
+			}
+			return
+		}
+
+		l.next()
+	}
+}
+
+func join(prefix string, keys []string) string {
+	path := strings.Join(keys, ".")
+
+	if len(prefix) == 0 {
+		return path
+	}
+
+	return prefix + "." + path
+}
@@ -0,0 +1,107 @@
+package application
+
+import (
+	"testing"
+)
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[string]int
+	}{
+		{
+			name: "tables",
+			text: "[Excel]\n\tkey = [\"a\"]\n\n\t[Excel.Skip]\n\t\trow = 3 # comment\n",
+			want: map[string]int{
+				"excel":          1,
+				"excel.key":      2,
+				"excel.key[0]":   2,
+				"excel.skip":     4,
+				"excel.skip.row": 5,
+			},
+		},
+		{
+			name: "inline tables",
+			text: "[Excel]\n\tdataset = [\n\t\t{ sheet = \"A\", rarity = \"SSR\" },\n\t\t{ sheet = \"B\",\n\t\t  rarity = \"SR\" },\n\t]\n",
+			want: map[string]int{
+				"excel.dataset":           2,
+				"excel.dataset[0]":        3,
+				"excel.dataset[0].sheet":  3,
+				"excel.dataset[0].rarity": 3,
+				"excel.dataset[1].sheet":  4,
+				"excel.dataset[1].rarity": 5,
+			},
+		},
+		{
+			name: "arrays of tables",
+			text: "[[Excel.Forms]]\n\tname = \"Normal\"\n\n[[Excel.Forms]]\n\tname = \"Awaking\"\n\tflag = \"Awaking\"\n",
+			want: map[string]int{
+				"excel.forms":         1,
+				"excel.forms[0]":      1,
+				"excel.forms[0].name": 2,
+				"excel.forms[1]":      4,
+				"excel.forms[1].name": 5,
+				"excel.forms[1].flag": 6,
+			},
+		},
+		{
+			name: "multiline strings",
+			text: "[Html]\n\tstart = \"\"\"\n<section>\n\"quoted\" = [\n\"\"\"\n\tclose = '''\n# not a comment\n'''\n\tothers = \"a\\\"b\"\n",
+			want: map[string]int{
+				"html.start":  2,
+				"html.close":  6,
+				"html.others": 9,
+			},
+		},
+		{
+			name: "dotted and quoted keys",
+			text: "Html.Icon.base_url = \"/\"\n[Html.Format.Attribute]\n\t\"火\" = \"fire\"\n\t'a.b' = \"c\"\n",
+			want: map[string]int{
+				"html.icon.base_url":        1,
+				"html.format.attribute":     2,
+				"html.format.attribute.火":   3,
+				"html.format.attribute.a.b": 4,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := locate("test.toml", tt.text)
+
+			for path, line := range tt.want {
+				v, ok := found[path]
+				if !ok {
+					t.Errorf("%s: not found in %v", path, found)
+					continue
+				}
+
+				if v.Source != "test.toml" || v.Line != line {
+					t.Errorf("%s: got %s, want test.toml:%d", path, v.Origin, line)
+				}
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	found := locate("test.toml", "[[Excel.Forms]]\n\tname = \"Normal\"\n[Html.Threshold.ssr]\n\thp = 5\n")
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"Excel.Forms[0].name", "test.toml:2"},
+		{"Excel.Forms[0].flag", "test.toml:1"},
+		{"Html.Threshold.SSR.hp", "test.toml:4"},
+		{"Html.Threshold.ssr.attack", "test.toml:3"},
+		{"Html.Others", ""},
+	}
+
+	for _, tt := range tests {
+		if got := found.lookup(tt.path).String(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
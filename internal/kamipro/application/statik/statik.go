@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00s\x93WX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00application.goUT\x05\x00\x01\x9b\xe3\xd8e\xacW_o\xdb6\x10\x7f\x96\x01\x7f\x07\xce\x0f\x833\x146\x92\xc7\x00yH\xe2\x06\x1d\xb6\xa5E\xbc!\x0fE\xe1\xd2\xd2\xd9\"D\x91\xc2\x91\xaac\x0c\xfb\xee\x03\xffI\x14\xa5\xa4(P=H\xe4\xdd\xef~<\xde\x1dORC\xf3\x8a\x1e\x81\xd0\xa6\xe1,\xa7\x9aI1\x9f\xcdg\xacn$j\xb2\x9c\xcf\xb2\x1dY\x1c\x99.\xdb\xfd*\x97\xf5\xfaV\x1c\x81\xd7T@\xfb\x0dp\xfd\xc2\xd5\xcbU\xa9k\xbefB\x03\n\xca\xd7\x15\xadY\x83r\x1d\x11\xae\x95\xa6\x9aU\x8b\xf9,\x8b\xa9\xeeZ\x14z\xdb\xaa\x92\xad\xb5\xacy\xaaFZ\x9d9\xf7\xb6\xeb\x83Z\xccg\x17\xc65}n\x80\xdc\xf6\xecDilsM\xfe\x9d\xcf\xb2\xf7/9p\xe2\xee_\x0d\xe9\xf5\xc2N\x16_\xe7\xb3\xec\x83\xae9!\xee\xee\x95fbt\xffu\xc4\xce6\xa2\xdcPM\x15h\xf2\xf9K\x18y\xdb\xc2M-\xf5\x1fp&\xe6\xfa\xfcEid\xe2\xd8-P\xc1\xd9\x02\xb6&\x9a\x16\x10F\x1e\xa0$:\x8am\xc5\x1a#\x0f\xcf\x1eQ\xb1f\xe8cp$\xf2r[\x02hB\xfc\xea\xc1\xd2\x08-\xf9\x13E\xa6\xcf\x89\x1a\xad\xd0\xea\x7f\xcf\xa5 \xa99\xcb\xa5\xb0\xda\x8f\xadnZ\x9dh\xa5\x15\x0e\x1d\xb3{\x8b\xbcz\xa45\xb8\xbd\x0cm\x05\xad\xc12\xdf\xaa\x1cDa4{)y\xbfg\x1a\xe4	\xbf\x89M\xc4\xff$O\x84\x89.#(OC\xbc\xcdu\x84\xff\x00\xb4\xe0L\x80\x8a\x12\x15\x8c\xcb\xa0\x8b\x03B\x08\xe9G\xa3\xb8\xfc]\"\xa8R\xf2\x82t#\x15@\x9d\xc4\"\x1f$\xd6\xd4\xa6\xbd\x1f\x05\xa4\x93\x0c\x1d\x8f\xf8\xe2$o\x9fH\xaf\xea\xb2\xac\xd0\x15\xd0\x13\x99\xd2:\xa5\xd1\x8d\x95\x18*OL\x18VL\xbc\xe2\xd4 \xa4\xaeX?Q\xa45h\xc0.\x9c\x8dK\xb0\xd64\xaf\xc6jj\xe5C\xfe\x1e\x14\xf3\xb3c\x19\xe7\xb8d\xc7\xd22\xff)O$V\xf04\xf96q\x11\xd3\x1dU\xf0\x0fr\x92^\xc3\xd2\xdcS\x05\xbb\x16]\xc7\xb8=\xd1\xca\x15\xc9[&\xd4\xa1\xdcQ\xd1%\xe0\x89)x\xd3B\x06\x94\xb5y\xff\xa2A(\xd6\x95\xd9\xb4\x0d\x04\x94\xb5y\x94\xa6	l gFt_R\xa4\xb9\x8f\\|\xce\xe4\xcet\xa9]\xe1q\xbb<\x00\x87\xa1\xf2E\x19\x97\x9a\xa6\xaeM\x91\xee\x9cDW(\x11\x03\xb2\xee\xdcs\xe9\xb7\xfc\x06<7 \xd7\x89\xfdY{\x13\x1e\x0e\xa4\xcb\x04j\x96s\xb3D?\x1aYxU(<d\xfbV\x03\xe9Gc\x83\xa0r\xc7\xd9\x84\xc2^\xfdhdbT\xc9\xe1w\xd1{f\xba\x1c\x1d\"\x1dw\x81>\xdea\x0f\xa3\x80'\xfd;\x89\xefP\xdb\x87\xf3/\xca\x04!\xee\x1e\xb4f\x96,\xda\xc5!Z\xf6\x81\xe1 o\xde\xfa\xc0\xd0Q?SSU#\xfd\x89\xfa\x1a\xca\x9e\x99(&\x08NL\x14>L\xad(\x0c\xc5P\xaf\x9d\xd8\x9ddv,\xf5\x98\x82\x1b\xb1\x05l(V\x02\x94J\x00\x85\x17']\xca\xdc\xa2\x1d\xfa\x0e\x94\x9e\xd9\xae\xffd\x1b8\x80\x18E\xb7pR\xb7\x07dyu\x1em\xc1J-\xe0\x8er*\xf2\x94b\xef\xa4\xa1\xe2\xf98\n\xa5\x95\x0e\xfd\xb7i\x9c8\x89\xc9\x87\xc5\xf8\xec%\x80\xbe<\x9e\xd8~/\xc5e\n@'\x8e W\xd3\x90+\x0b\xf9\x84\xf2\xc08\x90\xf0\xf4\x10?\x1d\xee!`~\xea66\xa0)\xe3\x84\x84\xa7\x07\xb8\xa9k\xa4\x0dS\xb2\x00\x12\x9e\x1e\xe1\xa7C\x17=\xcbT\xcbK\xbf\x0c&\xda\\\n\xe9\xbd4o\x9d\xcb)\x88\xf9j\xb8\xec W\xafA|\xb0\x01\x95\x14\x94\x93n\xe0!\x8d\x9f'\xf1\x0e\xa8\xd7\x03\xfe\xbdx\x8f6\xb2\xd5T\xb7j\xc2^\xb7jP\x10C@3U\x0f!!?\xd1\xbd{)4\x88\xb4c\xe6N\xba\x98x\xbb\x0d\xfbs\xf2\x851zs\x96V\xe8\xda\x93<\x8dO.7\xc2n\x99C+r\xf2\x08\xa7\xe5\x05Y\xfe\x16\xfd\x9a\xbc#\x80(\xf1\xc2\xae\xf3\x8db\xfc\x97\x15\xff\xc2\x18\x8e\xcc\xfd\xe8<l\xad\x11\xb9\xbe!\x07\xb5\xb2\x9c\xf3Y\xc6\x0eV\xf8\xcb\x0d\x11\x8c[\xb6\x0cA\xb7(\xcc\xdc\x1a\xccg\x99u%\xc3\xce>\x10\xae>6 \x96\x8b\xf8\x8flev\xb1\xf8\x11\xe6\xcc4D$\xb8\xb2\x89Y\xda?\xb1\xac\x80\\\x9a\xe6~}C\x0c\xa1qw\xe3DKt\x88\x9d\xf3\xe6\x86x\xe8\xca\xe9\x97\xbfF\xce\xfc\x88\x1bf\x83N\x1e3\xbc3(\x9b\x8b\xff\x03\x00\x00\xff\xffPK\x07\x08\x8d|\xd8oR\x04\x00\x00\xcf\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x1a\x92WX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00application.tomlUT\x05\x00\x01\x14\xe1\xd8e\xb4W[k\x1bG\x1b\xbe\x96\xc1\xffa\x98\x8f\x90\xaf`\xc9v\xe44'K\xe06.)mS\x13\xfb\xa6d\x83\x19\xadF\xda\x89V3\xcb\xcc(\x8e\x1a\x02\xb5\xdd\xd0\x986m\xa0\xf4\x90\xb6$PZR\x12z.4\xb9\xe9\x9fQ\xed\x90\x7fQ\xe6 \xed\xec\xee\xacbS\x9a\x8b\xccj\xde\xc3\xf3\xcc\xfb\xbc\xf3\xee\xfa\xf2\xea\xf5\x10\xc7Wfg*m$\x91\xc0\x124\xc0\xe5\xd9\x99J\xe5\x06\x10\x11\xd6?\xe1\xfa\xfa\xa5g\xdf\xdf\xdf\x7f\xf8x\xb4\xfbh\xb4\xf3t\xb4{\x1b\xce\x81\xf1?\x8e8\x91C\xeb\xa6\xf6I\xc8\xa8\xfdyl\xa1\xdev\\\xd9@&\x83\x92\x8c\xb5H\xf6c\x08n\xce\xe5\xb0\x0f\xbey\xf0\xfc\xf3O\x9f\xdf\xbb\xbb\xff\xd3W^\x1e\xd3\xf0\xdf$}\"q{L#\xc5\x9f\x9e\xb5\x8c\xcbh\xe7\xbb\xd1\xee\xbd\xd1\xee\xef\xa3\xdd\xdb\xde\x928\\4\x95\x94\xcb\xa5\xd5k\x98J\xa7\x1e)\x97\xa9Y\xcb\xa8LSd\x1a\x8d\x9c\"\xae$\x87\x85\xce\xd4\xee\x88U\xc8	R\xa6\xc8a\xa9<\xdb{\xba\x7f\xebIi-\xa6\x10Y\xe3\xe4\x1a\x89q\x17[*)\x91\x92\x9ce\x14\xa6\xe9\xe0\x100\xf8\x13\x02\x05\x1d\x1c\x02\x87Ev+\xe6eQ\x8e\x9e\x97\xa1D\x87\xc32Q\xd6\x9d\x1fu\x07O\xef\xc6\x1e\xa1p.U\xa1Gh\xb6\x0e\xee\xa5\xc8\xa7t\xc1\xd5\xb8\xea\xe1\xa1\n\xb1\xd3\n\x1a\xaa\xfbw\xef@k\x17\x8c\xcb\xd4~\x03P\xd4\xc7Z\xdf\xb1#\xf8\xffh\xfb\xb7\xd1\xce\xdeh\xfb\xa3\xd1\xf6\xa3\x97\xe0\x1c@\"\xc4\xb4Mh\x174\x80\xe4\x03<9\xe98\xf6\"\xcbh\xa6\xff\xf9\x83\xae\xcc\xce\xcc\xceT\xccp\xad\xad\xf7H\xa2(U8\xdb\x02\x0dPW\xb6\xcb\x17d_\x8f\xdd\x08\xa3vL(\x16 =\x0e\x1cm\xef\xc09\x00G\xdb\x1f\x9a\xe53\xb3<0\xcb#\xb3\xfcl\x96\xbf\xf4\xb2\xf3\xbeY\xf6\xcc\xf2	tX(\xa8\xda\xeb!\xa3\x9aD\x0b	\xbc9\xe0q\xfe \x1a\x1d\xce\xf7P\x9f$\x9c\xcd\xab:V\xd0\x16\xea\xa9\xa3\xf9}\x91\xf6a2\xc2|\x8b\x08\xec\xf5a\xda\x07_\x97\x98\n\xc2\xa8\xd7\xa7v5\xe9j7\xca6\xd5[h\xb3\x8dC\xa2\xbc7\xc3\x08q\x14J\xcc\x95\xdb\xdfO\xee\x1c|\xf91t\xce\xb4\x11q,\"\x16\xb7\xf5\xc1\x84\xe0*\xdd\x0d\x10%f!\xdd\x084\xc0\xe2\xa9\x85\x859\x10\xeb\xd2/.\x9d9\x03n\xce\x01$%\n{\xae\xd7\xe9\x93\xa9\xd7\xcbg\x94\x17\xb8\xa9\x93r\xe0MZO\xdd\x95\xb7?\xe9\xa9\x85\xd4\xeb\xa4\x93\x94\x03o\xd2\xd3\xca;\xe5P\x92t\xc9aZw\x99\xf6\x08\xf5$]\xd0\x1dk\xdc\x17\xd4\xa37i\xd1K%\x9d\x14\xfa5\xc6\xfbH\x9a*K\xa4/\x96RdY\xe0P*U\xc3\x18	\xd1\x08`\xc2Y\x87\xc4X\x04\xb0\xa9\x05\x0dcf\xfaB9\xcf[oc\x1a\xf7\xbd6E\xf5\xe61\xb1<\x1f\xd5\x9bF\xde\x0clm\x85K\x12\xc6X\xc3[|\x1d\xb44\xc1%\xa2*\xe40\xc6U\xca\xaaa\x84h\x17\x07\xd0d\\j.#\x13n`-%\xcd\xc71h\x93\x0f\xb4\xf6\x16\"\xe6\xde\xa4GW\xc1mr\xcd&\x9c\x1cR\xe7t\xf69i\xb5\x18]\x1c\xbbO\xc8\x9a\xfd\x006=\xbb\x9b1\xeeHe\xd2\x89|\x0e\x9ct#\xc7\xa3\x08x\xa2\x14\x10\xe8\x1a5\x02X\xad\xb6P\xd8\xebr6\xa0\xed\xb3\xe0\x7f\x9d\xce\xd2\xd2\xc9\xa5s\xff\x92\x91\x93=dT\xe2\xeb\xf2,8\xbe\xd2Q\x97w\xc5\x8c\x91\xe3\xe7\xa6\xf1\xae\x1f\x99w\xbd^_\xac\x9f\xf8Ox\xbf=\x1ej>\xce\x86ui\xb7\xd4\xd6\xcc-\xb0]\xe3tl\x86\x04\xdb\x1a\xdf\x92lWj\x90\xca\x18\xe5\xc50\xb5\xf3X\"\x12\x8f\xd1J\xe0B\x16\x0f\xfa\xd4A,@\x8e\xf7\xd5\xeb\xb9\xd8\xb4jWU\x82\xf4\xbb@\xf0\xb0\x11\xc0c\"\x80 fH\xbd\xfb\x1a\x01\x8c\xd1\xbb\xc3I\xa92\xc9\x8a\x0di\x929\x85G1\xe9\xd2*\x91\xb8/\xce\x82\x10S\x89\xf99P\xad^\x1d\x08I:C\xa3\x0b\x95\x13\x93\xc2\x11	\xa2\xcd\x8b\x0c\x9cG\x12-\xcf\xeb_9\x81\x0e_\xbb\xda\x1a\xe6\x82Q\x94\x161\x7f\xd7\xd3	g=AV\xbf\xf2)`r\xc9\x81(\xe62\x9a\xa8LU\x8e[\x88\x0b\x9coe\x1b9\x11\xcfc\xdc\x1c\x0f\xd2\x006\xf7\x7f\xbd\x7f\xf0\xde\xc3\xb4\xe3\xf5\x08,\xb4\x7f1n\xe3\x9d\xb5\xd5\xa3G]X;z\xcc\xca\xc6\xc6\xca\xabo\xf8\xe2<\xd1\x83\x96\x1b\xba\x9e\xe0\xd03<*\x95\x8a}\xeb\x14\xb52\xfb\xf9\xba99m\x1b8|\x96\x93\x17\xbfT\x12\xff@8D\xbb\xad&D\xb06\xce\xdd\xd5\xb2\xce\xc8\x13\xc7&Z\xd8\xde\xf3\xd9\xa6\x9c\xd5b;g\xcd\x8d\x02\xa7w\x8b\xff\xa7\xbe\xe6.\x16(;H\xde^`\x03i\xcd\x878iNzo\xc2$FC\x1b\x97\xef\"W\xcer\xc5\xb2rI\xc9Ik \xad6\x1d\xc21\x98|\xe5$(\xfd\xc4Q\x96\x006\x9fm?\xb6S\xc7Tf\x0b\xa9\x17\x9d/@[\x02\xd8<\xf8\xe5\x8fl\x04\xa1m?\x84\xb2\x04\xb0\xf9\xfc\xdb\x1f2\x012\x1a\xd06\xe6\x9e\x00kQ1_\xff\x99\x89\x89\xd5;\xce\x0b\x12\xdb\xef\x88\xfd[{\x99\x886\xe2=\x8a\x85(F\x8c-\n\xe6\x8b\x0f\xd2\xa0B-7\x86\x89-\xa3\xfd\xd0,\xe62\x065\x10\xf4C\x96\x01\xee`j_MY\x02\xc6\x10\xc0\xe6y\xf3\x94-\x0f'ao\xe8\xab\x8e6\xa81\xa7\x1f2A-\x14#\x1az\xb0\xac!\x80\xcdW\xccS&,\xc2(\xf6*a\x0cj8\xea\x87iE\xca\xfc\xedRQ\xdf\xe1\xe6\xcf\x9c\\B\xbdo\xef\x80+,\xdb\xf2\x12\xd0\xfb9\xf7\x7f\x02\x00\x00\xff\xffPK\x07\x08\xdd\x98F\x95\x94\x05\x00\x00\xe4\x13\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x92WX\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00statik/statik.goUT\x05\x00\x01\"\xe1\xd8e\xa4|i_\xd5\xcc\xf6\xe5k\xfc\x14(\xc8\xa4`\xe6T\x18\x04\x01EEd\x92A\x08bR\xa9b\x92\xc3 C\x10\xe5\xb3\xf7o\xed\xbd\xce\xe5\xb9\xf7\xdf\xdd\xf7y\xba_\xa0pNNR\xb5\x87\xb5\xd7\x1e\xea\xbcz\xd5;w\xd6\xb8\xde\x03\xd7q\x97\xd5\x95kz\xeb\xbb\xde\x9fW\xd5\xd5\xd1\xc9X\xef\xfcr\xef\xe7\xe5/\xbdo\xe7?|\x19{\xf2\xe4\xbc\xb2'\xd5\x81\xe3\xbbO\x9e\x1c\x9d\x9e\x9f]^\xf5\x0e=\xe9yvptux]\x8f\xd9\xb3\xd3W\x97\xd5\xc9\xdd\x8f\x1f\xaf\xf4\xa2W\xfe\xe7\xb3'\xc3O\x9e<\xf1\xd7\x1d\xdb{\xd49\xba\x1a\x1a\xee\xbd\x7f\xd2\xd3TWU\xef\xf8T\xef\xb3\x95\xc5\xb2\x0d\xe2\xb2\x0d\x92\xb2\x0d\x93\xb2\x0d\x82\xb2\x0d\xcc_\xff\x7f\xfa\xb4lk\xbb\xc9\x97\xfe\xc9\x8f\xc3\xff=\xf8\xa7:?\xffqd\xab\xab\xa3\xb3\xce\xd8\xc1\xd9\xc6\x97\xb2\x0dR^\x14\x96m\xe3\xca\xb6\x89/\x9b\xb2\xad\xec\xd6\xfeY\xd96uV\xb6aP\xb6\xb9/\xdb\"\xd3\xab\xf0\xbb\xc9\xcb\xb6\xa8\xcb6\xf4e\x1bd\xb7C?\xfa\xcb\xd6x}\xdfGe[\x04ek\xb1\x8bf\xfc\x076V\xdf\xcf\x95m\xe8\xca\xd6Tek\xa3\xb2\xad\xd2\xe7e\xdbT\xe5\xb3\xf9\xb2-B}\x0d\xffW\x95-\xdb\xc0\x96\xad\xaf\xcb\xd69\x95\x89\xf7\x1fT(UZ\xb6u2\xb42\xf5\xbel]R\xb6MS\xb6\xce?L\xea\xd2\xc3fymN\x9f\x8f\xc7\x14vH\x17Ree\xeb\xc2\x97\x83e[T\x1f\xc2\xb2-|\xd9\xda\xe6\x00\xbb\xec\xf4\x1fo\x97\xadu\x07e\xdb\x14\xba\xc20\x1b\xd4\x8f\xd8\xbal}V\xb65\xf6R\xb9\xb2\xad\x9b\xb2\xadqE<\x88WN\xcag\x87\xba\xce\"-[\x17\x95\xad\xaf\xca\xd6\xa4e[\xe1\xb5\xbcl]\xbdV\xb6M\xfe\x1b\x8f+[\x83\x0ddCP\xe7\xfeV\xd9V	\xe4\xd5\xe0N\xd7\xf3e[\x9bM\xac\xefps\xb4l\x1d\x9e\x1a\xbf\xd4e\xd4\xd5\xd9j\xd9:\xb3\x87\xfb~,[\xa8\xa4\xc1\xeb\x91^Xa\xdd\xd5\x87k\xec\xb1l\x1b\x0b\xad\x95-\xd6k\xfc1w[7\xa1\xbe\xe4\x8a\xc59\xbd\xb0\xc2\x9e\x83\x9c2\x85\x06\x9a\xb4l\xbd\x9d\xc0'p\xa5\xffv\x89\xb5\x95\xad\x0dU\xd0\xb5/\xdb\xd0\x94m\x13\xa9\xee\xb1\xd3\xa2y\xa92\xb1VDx\xbf\xf7\x13\xe6t[\xb6>\xff\x18\x87X=\xd47^\x1f\x94mX\xafv\xca\xd6\x98\x01\xa8\xe7\xb8l\xbd)[\x9f\x14ek,\x0c\xfb\x94\xeb\xca\xd4\xd2 \xcb\xc0\xff\x82I\xfd\xfc\xa3\x7fV6\x89.\xca\xd6UPR\xd9V\x06b)[\x0b\x0b\x8en\x06 >}'\x0c\xde\xa9.\xab\xa0\x7f\x1cO\xdb\xd3\xab\xc2\xaa\x0f7-[\x8b\xad\xb8Ul\xdc\xe3&\xf6\xbc\x1fvs\xb9\xb5\x06\x0b\xa4\x19[}\x94l?\x82\xd6F\xcb\xd6\x17X]/\xdc\x04*\x9e/[\xd3l\xc2\nR\x95\x8f\xcb\xd7f\x8eaP\x97\xc3X/\x0ch\x8e\xf6Po\x0e\xee\x1cAjseka\x1cV\xac\xf6Y\xd9Vp.\xf7~	b\xfeV\xb6&S%\x9b\xa8l\x1b\x18y\x82_\xbcj\xba\xb0]\x1f:\x84c\xafa\xd9\x0f\xd8P\x7f\xd9Z\x03\xdb|\xc0?\xd4\x90\x81\xb7\xbe\xd0\xfd\xda`^\xad2\x84Q$X\xebS\xf5\x1b\x97\x8d\xab\x03b\x07\x15L\x02\xef\x06\x9fV\xca6L\x9f\x8f\xa8\xd6\x8b\xfcM\xd9\x86\xb9\xdaL@\x80\x82V\x9aph\x8c\x00\x91\xbf\xdf\x18\xbe#\x944\xef\x81-+\x834.\xb3P\xb6\xa6\x10S;\x82q-\xe8e\xae\xe8\x17q\xf4\xc1\x8a\xa0\xcb\xa2l\xeb\x1a\x82\x86\xb3Tc\xe2\x85\x9d=\\|Z}\x84Ga\xed\xef\xf2M\x98\xb8\x99\x0c\xabmh*\x84L\xd6\xcb\xd6\xc4\xea\xf05\x04\xee\xb1\x02\xfc\xd5$_\xd6U\xdc\xd8#\xdc\xbc\x06\x9e&'K\x10\xde1\xf44\xb0\xf9Z\x01\xc5\xf97\xe14\\ \xbd([\xe3\x16oF\xb0\x96X\xbd\x08\xc0\x02h\xc2\x86\xa0\x1c\x88J\x16\x9ccoek\xb08\xa3\xb0\x85\xbf+@\x82\xefU\xb4\xc4\x86\xc2Jm\x1bnk\xc2!\xf1m\x08\xf0\x9a>P\xc7\xa2\xe4\x12P\xf8[\x01\xc8$F\xb5U\xa7-\x9cJ\xefZ\x15+X\xf6\x8b\xb2-\x8a#\xc0+\xee\xbd\xa2\xb8\xe0\xb2\x0f\xea	@N@V\x15\x16\xb1~LP;\xea\xc5\xaa\xff(\xa0\xba(\xee\xe3m\xf1\xd1X\xe5\x84\xfb\x01\xcd\x01$\x0e\xea\x16\xb7\xc7\n\xb3W\x8a\x87\xb5\xf9\x91\\\x8c\xaa1\xc0\xc5\xf1<|\x02\x18S\x04\x1d>\x1c\x08\xe5&\xcf\xd5r\xa1^<\xc8\xe1\xe3\x85\xaa\x1f\xff\x0b,U\xb4\xd1&W\x14p\xe19\xdf5X\xba/\xf2E\xdaD\xacq\xc2\xa4[\x82@\xcffo\x0eS\xfc\xf6\x90 <\x99\x91\xdbdi\x88\xce\x85\xd7\xfd\xbb\x81w\n\xd0!0\x18\x06\\\x0b\xf4\x13\xe0\x01\x8c\x06\xf6\x1a\xd0\x8e\n\xd5\x1fD\x116\xf0\xdf\xa0\xec\xb4\x8a\x00\xa2\xdfX\x81\x0b1\xb5N\xff`O\xbe|\xf6I\xdd\xa16\xf7z\xa7\xa2\xfa\x8a\xed\x1f\xea\x03M~\x08\xbb[P\xd7\x86\x91\xd6\xf6\x0e\xb6\xdc7\xb7\x00;\xfd\xf4i\x038\x82\x0d\x1e@%'C\xf7^\x15V\x98^\x13\xaf\xe2\x89\xb8;\"K\x14@qs\xd8\xc5M};\xa2\xf6\x0c\xf16\x89-;\x1d\x8d\xba\xa1D\xad\xa7\xdb=\xf0!\xc4\xf8\x08\xb6\x06xpb\x9f\x99^\xe2\x1a\xac\xf6\x96\x9bJ\x00y\xd7\xb8q\xbfj\xbe	\xfa\x00\x00*sh/\xcc>\xab\xaa\x10\x15M\x80\xf8\xe4\xc7\xf0\xf6\xe8\xb6\xae\x01\\\x02f\x12\xc6\x9f\x815@\xa7\xd0\x02\xb3\x92/\x93\xe0\n\x0b0FDp\x95'~\xf7r\xafF\x82*\xfe,\xde\x13n\xbc\x1a/\x90F$\x9a+j\xc2}\xa0\x17@_\xa9\xc1+\x08\xae4\xfc\x06\xe6rJC*,\x07\x10\n\xf77~Hm\xd3\xd8u\\\xb3\xac!\x00\xca\x06\x88W\xc9\x8b{\x84\xafe\x0dr\x16\xcb\xc9\x00\xe1\x91\x99i\x7fa\x9f\x8a\xa5u\xf5\x0e\x84%x.\xee\xd1\xa1\x99\"\xf6\x86\x0f\xe3WjHu\xfcI\xd5T\x03\xf9\x93\xd1i}\xd9ek\xe0+\xd0iz'\x06P.\xbd\xc13\xd5<l\xb0AG*\x1et\xf9\xd8\x8c\x89\x97V?\xbc1Wd\x85\xfe\x08\xea\x99\x84\x81\xde\xce\xa8h\x10\xe0|\xa07\xb6\xe9\xbd\xca\x15\xbeQ4\x03X\xef\x86\x06\x02\xa10\xf8\x03\x86\x07\xd8\x82x\x83H}\xb6*\x10aM\x8fF65~\x90+\xa8\xa6*\xdeOx\xf5\x06\x04\x04X\xbf!\xda\xc1<\xea`zp_\xb1\xa2\x8a\xf3\x83#R\x1f7\xa5\xa0\xe8\xe9(.\xb9\xecS\x7f,2\xd5\xca3x\xc4\xe0\xafoJ\x1c\xe0|.\x15\x18<\x83\xf8w\x11\xb55t\x85\x8c{\x8d\xb9&\xf1\x8a\x14(\xbd'\xd0\xf2\x02D4K`\x80\xea\x0b\xb2U1\x18\x81\x01\xa1,\xbbF\xa3\xbb\xd0\xb5Tc\xa9\xf5\xd1\x8dJ\xceF\xfb\x9d7\x80\xae\xa6\x88t\xc7u\xf5\xb6Roi\xb2\xef\xaf\x15/\x04t\xc3\xe2\x86N\x00\x98B\xec\x17y\x85\xb5>\xde(*\xb4\x95\xdb\xf4D\x00\xa1\xca\x009\xf7in\x1e*\xc7*\x96\xd4\x06\xe0\n\xdem8\xf5\x1f\xa0T\x95\"F\x02y\xeb\xfc\xbb\xd2\x7f\xb8H\x8d\xbb\xd4\x83;\x80\x819\xca\xb4\xc9U#@\xd5*|\x81\xa0\xb4\x8f\xdb! \x88\x16\xfa:*\xab\xa0\x19\xbf\xd3\xe7\xc1f\x10(\xaa\xe0\xc7\x98^\n\x7f\xe8&\x0bX\xb8\xf7\x92\x8d\xe4|4\xb2\x82\xe43\xb6\xfd\xf61\xf4\x07\xf4\x0bM.\xf0\xf3\xdf3\x18F\xc0hk\xfbo$-\xff\xf1\x13\x06\xff\xdb$\xe6\xea\xec\xf4\xc7\x7f\xa61\x12\xd8\xe0\xdeXy\x9dl\xed\x9e@n\x0b\xf8G\xedHlC\xc0\xa7R\xe1\x00\x1e$S\xf0\xdfa\xbd70\xdbdp\x11b\xcd\xc6\x86O\xd7\xe9I\xd0\x7f\xd6\xa8'\x85\xc0\xddF0\x01~\xb1\x19\xab}X$\x1d\xc6\xe9N\xe1e\x80\x83\xa6!\x88\x99\xecT\xcd\x1bh+L%\xeb_\xd9\x01LF\xbf\xc6\x12\xb5\x1e'\x06\xbc\xaa\xf0\\H\xee\x85?@:\xf1\n\xb4..i\xebu\xc6]y\xec\xb1r9\xb8\x01\\\xcf2\x85\x12$\x8a\xf5#\xd8-8\x8c@x\xaa\"\xb5\xf9\x96?\x189\xedg\x16\x06AG\x89\xfe\x02\x17At)\x8a\x8f\xfaG\xc0d\x10\xee\xd6d\xd3*d\xb9)\x90$=\xa0\xb7\xf2\x07\x8c\xd0\x9bV\x9d\xcb\xd7\x87\xfc%\xbe\xc2/\xf7\xaa\x0cK\x00\xf0\xe14\xa4\x06\xdc\x0f\xe7\x94\xe5!@{P\x19\x84-\xb0\x00\xdf\xdc\xfdPGk\xdc\x0d\xb0\xbf)f\x06\xc8\xf9\x88\x0bu\xfa\x1e\x1f\x02\x831\x1d\xbd;\xd6\x0d\xc2\x02\x13\xaf\xdd\x9dZ\xbf\x17\xa1,+P`\xad\xf0,\xfc\xc0\xf8\x9bx\xeb\x9b\x82+\xc2\x838\x8b\xef\xffS>\xbb\xb8\xff\xd4\xa7i\x84\xc0\x8bS\xd5\xe2\xb1\x16\x9b\x13o%\xa1pL\x16\xbb\xbf\x0b\xf4\xc7\xb4\x83Z\x15UD\xa6,\x13\x05#\xf0;\x01\x17H<=\x81\xa1|Tz\x19\xbaa\x8d}U\xf1\x95\xd7\x98O\xeb\x8d\x9a\x170\xa1\xb0\x92A\xb8~ew\x80`\xeb4o\x10\x1aj6 \xc1\x9e52\x1e#\x98\x8f|\xb9\xbeW\xe6\x065\xb9\xfa\xc3\xd1\xa8^\x11\x06_\xc9\x03\xc9WMq\x01\x0c\xdb\x1d\x19fp\x01\x94\xe2\x11\x92\x8fgj\xafN\x1c4P\xc4\x87\xab\xc0\xf2\xe1\x92\x08\xd5\xa0\x00\x81\xb0\xcf\xb77/`\xc9H\xbc\xe0\"\x90\xa1\xec-\x9c\xd7\xd5\xdb8ZUl*\xf09\x7f\x0d\x10Zf\x84\xcb\x98\xd2\x85\xe7c\x1b\xf8o\xe1Pc<\x00H\x1c\"\xa5\xad\xe4WL\xdaRe\xfa0w\xc9,C\x84\xedZ\xe3~Gw\x87p\xe0\xeb[<b\xe6\x84Xm\xc9\x0c\x13D\xe3xE\x85i\xf3\x1d\x9aJ\xd8\xc7\xd4\xd53\xfb\x97\x80\x18R\xee\x8e/\x8a\xbaC\x8d\x12\xc2Y\x89\xd1\xa1\x9d\x81\xc2\xc0\xd2\xeadI\x08b\xa2$\x03\x02\x0f\xe2\xc9\x82$\xcdK\xa0\x14\xd3\x12W\xf0\x0c\xd1\xb12f\xf8\xb7\xfd\xd7\xff_\xf0\x0bxv\xa3?>G\x0c\x8f\x866\x8c\x1a\x9c\xa8\xa3Y\xd9R\x89awa\xde\xec\x93\xe47\n\xcfU|\xf3i\x08$\xafwJ\x85\x1aJJ.\x99#\xb8]\xb0\xa0\xf7B\xc6\x0c\xd8q)\x88l<	\x10\xa9\x91\xbf\xc1[\xec\x0fEWd\x92\xc08\xa4\x00\x80\x9f\xda}UY\x08.E=W\xdbC\xea\xd5@OW?\xa5\x90\xaay\x10\x8a\x1e\xc0\x96X\xd5\x05\xe2S\xaf\xaa\n\xa6\x84\x1d\xfb|\x05.\xd8\xcf\xf8\x8d\x1d\xd7\xe3\x9b\n\xb0\xc2j+%\xa3\xb8\xa1G\x9eS\x88\x1a@\xa7m\xf4[\xe1\x12\xfb\x05\x90\xfa\xb4\xd2%\x04\x88\x9a\xd0Qa`\x1aR\xa1\xc2\xea`\x9d\xf9\x0d\xf8Bq\x0c\x03\x85*\x9at\xf7P\xb7k\x9a9\xe5\xe9\xb5\xcd\x15z\x03\x03oI>\xeaF\xe1\xdb\xe2\xef~Ym4\xb4\xbf\xb1\xa8\xf0J\xb7\x02@\x0c\xc3\x8b\x97\xd0\xb4p\xf6\\\x81\x19\xd6k$\x07\x1f\xa1~\xe0\x0e\xc1s\xff\xb4\xab\xae.DG\xbaW#)P\xa1\xf0\x0c\xf4l\xa2Wc\xf0\"(.&\x14\x06a\xff\xf3\xb2s\xaf\xf6#\x89V\xa1\xd4_\xd2\x07l\xa0>P,\x92,\xc9\xb0\xe0\x10_b!K;$\xc8\x92\xc3\xe7\xd3\xfa\xe9\xee\xb3\x05njeM\xb8c!\xb6|\xa6\xdc\x060j\xab	X\x0c4\x17a\xf3E\xb5\x83\xbc\xa3P\xa3\x80:|\xda\xbfG%6'L#r\xbds\x15\xe7G\xf0\xa6\xc5\xe7\x04\x06\xc4\x11qw8F\x8d4\xbf\x1aY\xc4\xd3_\xc1\xfa\xd6\x1a@X\xb02\xb2\xa1:	Ao\xe1\x8b\x81At\x8f\xbe\x12l\x92m\xe5*\x08\x0f\xdd\xec%h\x90\x86\xfaj\xb6\x005\xdc=\xb3j{^\xd2\xc6\xec\xad\xaa\xc7e\xe0\x99\xe1\xa4\xfa\x02`\xa6\xa8\xdf)\xaa\x07\xf6\xc7)D\xb2\xa8d\xd5f\xdbX\xc3\xeeo5\xdf:\x81\xde\xc2\xe70\x9a\x11x<|'\xdb\xd02[\x05,2\xc9\xe1c\x95\xa5	\x9a\xb2<\x1eC\x16\x16w\xbe\x8f>Wk\xb7yx\xa8R\x96\x98\x96\xa8\x08C\x81\x0b\xe1\xaa\xc2\xd7\x17\xb6\xf0\xa4\xd3K|f@]%\xb4k\x08SW\xf8@q\xa4\xe1+4{\n\xd0u\xbd\xac\xf0	\xd7\xf6\xb0\xb1 \x9b\xd2\x88V\xd4\x12\xc6\xde;f\xc1\xc5o\xc5\x87\xc2^\xf5)\xc7\xae\xc4\xf2[\xf5\x05hG\xcc\x10\xa1@\xf2\xb3h[Q\xa9\xce\x00\xa6p\xbd\xfa2S\x1e\x04F\x03Or\xdd\x1a3\x9d\\\xec&\xd2L\xa2J\x7f\x92\xf2\xb3F\\\xc4S\xcd\x175~\x18\x9d\x8f^\x1a\xe5u\x0d\xc2G\x91\xdfj\x98\x06\x90\xe3r\x89f\xacpx\xd6A\x05\xc4\x8a[h\xf5\xdb\xbeV\xea\xa4\xc2c\x96AX\xfc\x14b\xcd\xf1k\x12\x94\xec\xfa\x88(\x9fht\xb2v\x14f\xb3\x87{_\xfd\xd0\xb4^\x12\xd5Z\xcd\xa5\x91\xc5\xfe\xd8:f!\xbc\xd2\xa4\x0b\\\xc4\xa6\xcc\xe4}\xbc-n\x1f\x11\x85C\x90\xac`V\x81@\x12T\xdc(y\xf3rF\x83%L\x10\xa6\xe6\x98d@\x8eur\xbc\xb5\xa1\xd9\x8d\x80m=\xa3\x84\xa5&I\xb3\x81\xb0\x03\x13\xf4\xfa\xf7\xcaH<W\x08\x9a\x0c\xf9H\xd6\n\"\x16\x7f\x80\x13P\xe2`	As\x84M\x88\x83/0\xd5m`\xb5\x92d\x99W\x94\x0d\x14\x1e\xackf\xe8\x0b)K5\xdf\x98\xa2\x9b\x0f\xe3s4\x0e\x16\xc9\xa50\x19+\xbe\x86a\x8f\x1a\x8c\xd4\xc6k-\xad\x15\x164=\x1a\xd5r\x90\xab\xf7\x0b%\x88\x81\xdb'\xbd\xaat\x03RB\x13uK\xbd\x83m	\xa0X\x13\xf7\x1cL\xbd\xd9d\x0c\x8a\x86\xbf_rS\xe1\x10>\xd6M|\x8d\x14]\xdc\xae\xa6k\xb2@{\x06\xcb\xa8\x0b\n\x0f,Q`\xc8\xaaB\xa4\x08\x0c\x0e\x0cU\xe4\xacO\x08\xcb\x0d\x1e\xb97\xfc\x11\x06\x07\x9c\x08\xccK}!\xf7aG_\x86}KF e\x95\x85\xbd\xed\x89_\x8c\xe1\x95\xc2\x99\xfc`}\xf5\x19\xf3\xe7h\x92U\xaa\x98\xd5\x8f\x9a%7\xa7n\xd3\x04\xf1\xe4\x88\x8a\xd7T\x7f\xd4@B)\x18F\xaao\xd0\x86\xba*\x8aS|\xd6\xfc\xd6\xbb@\x0b\x02\xf3\xfe\x8b\x1a\xa1\xf73f^e\\5\x03\xf8\x0d[\x15_l\xc8\xa5\"R\x0er<\x9b\x0f\xec\xa8\x1db\xf3\x88\xf2R\x8b\xca\x14m\x9a\xb4\x9e8\xa0r\x1be \x15<\xd3\x90f\x83\xdd\xd6R|\x1e\x83\x02\x87&\xde\xe3>\xdf\x90\xee\xc6G\xfc\\n\x8e>\xff:\x83\xb9\xf4\xbf\x9b\xd3\x9d\x0b\x8f\xf2\xe5\xb3-)\xb2\xab\xa3\xd7\xd1%\xf2\x84\xe2\xc3I/\x16\xcf\n\x91\x8d\x00\x15\xd8\xafK\xfb\x87\x14\xd4l\xb2\xca>\x166W\xf8\xd3i\xa0\xd2:\xf6\xf2\xc5\xb3\x96Q\xa8`\x8a\xe6\xd5\xcc\xae\xae\xbb1\x93\xd4\x85T\x14\x7f\xa8\xb3W!>mHr\xba\x81\x04\xc2\xf6\xb6\xcb\x94\xf0\x06\x84+\xa0\x10\xb0\xf2[H\xc6|\xb2\x1a\xee\x13\xd6Xy\xc1O\x93N0X\xd7\xac\x7f\xd4\xca\xc1\xa4\xa6\xd0\xec\xde\xf3r \x8e\x8b\xa6\xd4UM:\xf4\x0e\xee<*e\xa9\x8c\xdeD\x8b\xad\x82\xd9\xd1\x9c\x95\xc7b\x94\xb4H\xf2\xd1\x97t\x87 c.\xe8\x93\xb9\xc7.\x9fs\xdf\x8f\xf7\x97\xbe\xaa\x04,3?\xe1\x1c\xd9\x1dK\xe2\xc9(\x1e3X\x9d)\xd8W5\x1cJ\x1a3!\xb3\x17\x1f\xbd\x19\x855\"\x84U\xbf\x18j\xedS%\xbc\xb6\x81\x12\xed\xfa\xc0K(\x0b\x1a\xcaF\x00<\xf6\x98\xa4\xdd\xe4Go\xd5\x1b\xa4\x17\xd3\xac\xad*\xb4J\xe5-S\x88(lY\xce\xc2\x15\xcf(\xa7\xe0\xc5K\x95\x1a\x84/\x12\xc4\xce\xe0\xd4]\xb7\x152\xfc\x7f\xacv\x08\xc71\xef\x1e\x13\xb3\x7fU\x16\xc8\xd1%\xbd\xf9\xdb\x05\x0f\xe0@\x11\xfe\x7f\x16<\xd8Bf3\xfa\x7f\xf6m\x05+\x03\x16<\xaad\xe7d\x17\x0b\x9a 'o\xd8\xb5\x00\x16`\xa9.U\xdc\x95\x1a [\x9aU\xb6\x8a\x8f\xbcU\xe8\x93\x02J2\x00G`\xab\xc3\x15\x9f\xa1\xa4?3\xb8EO\xaf\x96\x8d\xc0;`\x08\x88\x9e\x15\x9b\x00.\xbea(\x8d_C\x0f\x7fh\xce9\x19\xad\xa47\x1f\x067N\xe0\xa9;\x1b\x8a\x8a6\x99? yI\xd4\x85,\xd3C'\x81\xf0\xe7=\x94\xbeG\xd0\x90\"\xe5o&\xedA\x97.-t\x01vkQ\xb7\xe5s\x900?^\xb6\x95\xdfW@\xc9\xd9\xc1\xc4]\x9d\x9f&\xf5h4\xa6A\xf1R\xb4\x8c:\x84u\xafL\x1c\xc1\xa3aa\xc6\x17o\x0fX\x1c\xf4g\xdfy\xa7\x80\x90\x06\x1c\x08\x8b\xb3\xe5\xe5\x01\xd6\x1eX\x02\x80\x0dx\xf6\xa9\x84&H/(T\xc9\x07\x8d\x06o\x90\x00\xcf\xe0\xe2sR\xd4\xba\x03\x19\xb2y\x12\xba\xb2\xd4\xa8m\xecM\x87\xd8^+\xdaJk: \x7f\"1\x17\xac\x86	F\x13\x7f\x89\xbc\x885I\x7f\xbe\n;\x18\x18xD\x1e	\xe0N\xc3\x87\xf3\xf7X\xc4\xa7\x1fL\x8eXQ\xf7\xc9\xd9\xc3\xe4\x03\xbb80\x13\x7f\x7fs\xf3[=\xaca!\nQ\xaa\xe9\xd6\x9b\x18\x1aDp\x95\x1a\x81\xaec\xb6\xb360~\xc6\xf0\xcf:\x10\x96nX\xb7\x97\x90c\xa7\x10(\xa5\x01\x01\xdc-F\x84u\x97\x9d\x01\x90\xb0\xe7\n\x05F\xfa\xae\xd7\x92\xf24Z\xcbC\xdef\x84\xa7~\xb8K/\xb1<?\xcf^\x8eQo(\xa4C\xb3=8\x80\x87\x196\xb2\xebI\x06\xdfP\x0dY\x00\xbc\xe0\x82\xd3|uW\x7f\x95t.\x99\xdfE\xda\xdb\x84_Y!\x92\xe8\x9d\xec@\xc5\xa9\xa2U-M\xdc4<ZR\xef3\xdc]\xe3~~\x9ae\xf5:W\x0e\x8c\x08\nBd\xc5\xe3\xfcW\x16F\xba\x15\x04\x97\x0f\x8c\x1f\xd0ca\xb3\xddN\xa4\x94I\xd2\xdd\x1d\x95\x9c`\"\xf4\x12\x87\xd7\x13$X\xb5V;Tz\xdf\x84\xd3f\xfaRM\xcf\xd5u\x8f\xebz\x84QVS4\xa8\x08&\xe0\xcf\xa9\x1f\x12_\xc7p\xe5\x0c\xcb\x8dU8\xc2\xf6f\xd1wj\xeb\xa1\xa5\x1f\xe7\xcc\xc2\xeb\xa5\x1f\xecV\x17lj\x90:\x98\xec\x82T\xd8\xa8h$\x11\x07Z@\x8b6\x9ag\x81\x8d\xa4\xb9\xe2-j\x11\x15v\x19\xed\xe0\xc1\xd1\xd9\xe6\xd2\x95\x12\x93\xc0Cwn\x9eLCBS\xdf{\xca\xc3)%1E\xb6\xa0\xd5\xd0\xd0\x82\x1bV\xbf\x9d6.\xd8\xcf\xf4j\x1a\x06O\x08\xe3\xa9\xae\x0e\xa6\x00K\xa7\x92c!mM\xe7\x81&\xd3\x96\x03\x11\xf9@\xf9\xec\xb9&CM\xbe\xce\x1c$\x94\x84\xff\x86E\xf8\xe8 !\xcbN\x1f\xf3+C\xfe^y\xfd\xb0\xc9/\xd5\x83l6LV$	\xf5m\xfc[\x03\xb6T\x1d%\x19\xffX\x93\x9e[M\xd1\xd5p\xe0\x83\xc8V\xc2\xc1\xf3\x03\xdc\xba\xd0\xbc\xacqpa\x98\xa4\xf6i{\x06FN!\xbaE\x89\x86\xe6H!*t??\xe9\xe3\x8bl\xb6}\xe4\x8c\xd8\x88\x0b\xaeDN\xe2\xc9^\xeag\xf5\x02\xbc\x199e\x0d\x93\x8a7\x14F\x9b(]\xed\x96r\x12\x95}\xc3!\xa0*\x10'\xb8\x93gwH/,\xfd\xa9\x00	(\xde\xcf\xbd\xc4\xee\xce\xa1\xbe\xf7\x7f\x14\xdb\x1d\xcbfR\xb2j\xd8\xad\xf3\x03\xbf\x004\xfd\x90\xc7!D\x1a\xe1\xd2\x80\xa1\x0d\xf1\xa1\xe1xR\x18\xbc~Xx\xae\x8b+\xec\xa2v+\x1a&z\"2\xd8\x9c\x8f\xf7\xd4\x0d\x02W#\x04\xc6\xbbJ\xf8!\xf1\x8aa\xd51\xcc\x02\xdf\xea\xe4\x80\xf8\x1c\xea\x1d\xc5\x8d\x9a\x8d\xde\x95\xefzw\xb8f\xb7a\xea\x0d\xd4\x19e\x9e\"\xac\x99m\x05\xee\x90\xa9\x93\x04\x12\xa41\xf2r\xa3\x80P\xf1\x99\x82W\xe6\xf8Z\xd1\x13|\xc0\x04\xe3\x9a\xbb\xda\xf4A\xd7\x84k\x01S6\x9c\xc3V\xf1\x00\xfbG\xf9\x15\x9eh\x9bG\xdfF\xbc\x0f\x91\xb9V\xe1\x0d\xc7A\x82\xfa\x92\xf9A\xf1\x16\x01\xfa\x0ev3\xbfw\x89\xcc\xef\xfa\x98\xa0\x83\x0d\x9a\x9f\nk\xa1L\x02\xc4\xd7$\xa61\x0d\x0f\xec\x14!\xc8T\xa7\x8c\x9b	;{\x8er7\xc7\xdf!\xabAu\x0b\xc4\xdb\xc0\x1e\x912\xa7\x9cIA\x08\xcd\x97\xbe\x01R\xc2t\x06WYU\x82\x97\xc2\x1c\x13\x86\xc22\x9dp\xa4\xdd!\x1b\xbd\x88u\x92\xcb\x16\x9cf\x898\x86Q\xb5\x8a\xd2\xd2p\x89\xbfm\xbd\xfa\x84\x9c'\xb5\x03\xfa\\\x13\xcen\xb2BR\x11\xf3\xa9\xdf\xcat\xdb\x7f\x0bX\xc8\xdb\x8f\x8f	a\x9d\xb8\xbbm}\xa6T\xed}7\xf8\x9cpp\x87I\xb6\xe5\x06MvFz\x91\xfe\x91\nc\xde\xbb\"\x94\xa8\xc4\xed\x072\xd5\xbe\xd6iu\xe9\x8d\xb9\x90\xe1\xa9<\xee\xbc\x86\xe9 \x0f\x92\x14\x16\xfa\x95 Y)\x03\x84\xac}\xba\xee\xb4FQ\x04\xc3\x8ch\x04\xcfB\xa6(\xe2\x81\xdf\xec\x00\x07\x8a\x062\xe7!-/,\xc1;\xf6\x13\xac\x945\xd9)vY\x97\x9f$\xd3;\xf3*\xc4\x80\xea\x939!H\xaf9x9\x84\x05\xda\x15\x10\xd17\xd4{\xc4|\xa0\xd0u\n\xbe\xd6\xc4\xc4\xe0^\x95T\x98\xb7l(e\xef\xc9\xab\x1dib\xd2\xfb\x85\xb9tXv\x0e\xe2U\xfb\x13\xc8\xc9\xbc\xa8\xf2\xa3\xfdO\xaf\xb6G/z\x9d\xeaK\x06\xde\xb0\x97f\xb3\x0f\xf28U\xc3\xf0\xc1\x9a\xd1*Z[$\x12\x19\x86\xd5\x8a\x11\xe3%!M~\x12Z\x8ac\xc2\xaaYc\x91\x08\x8b\xce\xbe)'\x90)H	}\xdbZc\x08D\xd0[*`\x18>h\x15\xb4\xe5\xa4\x1a~\xc7,\xa3`\xbaPDn\x83\xa4\x14&\x91\xbfg\x11@v|\xf3G\xc1\xda\xb03\xd7\xc4?V\xd8\xa2L\x17b6\x13c\x8d\xbfR\x03\xa8\xe6\xdeO\x02o\x97\xd8\xb7\xceUn\xb6\xeeau*~\xa7\xcbu\xf9\xbe\xbe\x1d2\xd2V\xc8J\n	8\xcb3\x1co\xab\x92\x17\x9a,\x9a|V\xb1\xa0\xca\x0f\x84f\x96\x8a\xe4B\xaf\xc99\xa4\x9f\x07\x819\x890g\xd8\xda\xf3\x17x\xc05GE\xa0\xf3`\xeb\x808\xe8T~M6\xb7\xc7\x99\x85\xf8SS\x96\x83W0\xe8\x0fe'a?N*\x15\x0fj:\xb5\x7f\x80\xe5\x0d-+\xec\xb9pCg\xc3\x9eiT\x84g\xfb4\x98`\xcbL\xd4H\xdc\x14\xb5\x06\xe7sR\xfe\xa63i\x15\x0d~xR>\x93\xfe\x7f\xc1,P\x88\x9f\xd4\xb6\xcf\xba\x15\x95P7a\xb8$\x19\xfb\x08\xcf\xd9\xdd\xcf2\x0e\x11\xb2D	\xec\x166\x1d\xb0J\x10\xb3;\x19\xaa#\xd6v\xedt\x9as\xa1\x86\xd6og\xf64\xe6\x06\xf1\xed\x19\x91Y\xbc\xf8\x08\x9f\xffF\xb0\x15\x1a\x1d\x9f\xe9B\xc4\x17\xa5\xbc\x7f\xadA%\xe8N\x1f\x19\x0et%\x8aH\xa6Qt\n\xb2\xcfRo\x9d\x18\xdfU\x91\xc0x\xeap|\x04!\xb0\xea\xbd\x8892),^\xfa%O9\xee\x04b\x17^\xdf\x9d@\x1a{\xaa=\x88\xc5\xb0O&C\xa7\xd2`z\x8d8m\xd2\xd7\x9c\xf8\xc9z\xd5B\n\x19H\xf9\n\xe1\x8d\x82/\xc0\x85\xf2\xadK\xc5Q\x19\x04\x8an\x99l\xa4\xe0\x92?\xb1\x80\xbd\x88[\n\xd5{\n\xd3w\x8f\xe7\xfd\xd6\xb4\xb6*f7\xa0\xc1\x1e,t]MY\xa8Mmw\x95\xcf\x05y\xdb#\xe1\xac\xdbh\x0de\xea\xb0Ig\x99\xff\x8a\x19,3eIY\xa7\x0f4\x93\x12\x84\x97\xb6\xdc2\x03\\s8\xba\x00XJ_\x81\xcd\x14\xac8\xd7\xac}\x04\xecwD+\xf3/6\x97\x94\xe2\x04\xf9\x1d\xbb\xd8\xd0D1\xf6\xed\xc3\xa5\n\xde\xa5y\x97\xc2\xaf\x97\xe5\x9a^\x14\xda\xf7C\xac\x97\xf8n\xcf\xc8\x82;\xe6\x90\xbb\x81\xeb\x15\x8b\xba\xc0\x82\xd1 \xa4E\x89s\x82\xa0@\xe5\x95\x7f>v9*\xe5\xabYx\xc5:\xc8Xsv\xfeY\x15\xd5\xb0j#I \x82a\xd0O7\x97Lr\x0f\x8b\x19>fx\xad\n\x04\xfep\xe6\x98\xb5\xac\xf4\xf3\xba\x9a\xa2\xd0t\xcfA\x1a)\xf2\xb9}\xba5\xfc\xce\xf7r\x082M\xbb\xd1\xae;\x0e\x1c\xd0\xa0Bw\x8a\x9b\x8e\xcd\xab\x0d\xe5] A\x12 s^\xcd\xe8cC\x13Q\xa2aF\xef\"	\x931\xc7I\xd9G\xec\x96Y`\x87Ew8\x87\x94\xc9R9F@\xab\x03\xbe\xb6\x0b\xf8|\x01\xb9\n\xc5\x82\xa1\xdb[Eb\xa1\x8c\xe1W]E\xcd`\xe8\x83\xde](D\x80-:8\x15\xaf\xea(\xacI\xab\x17\xd7FP\xb58\x85SN\x11V\xf04?\xd5\xa3\xbb\x901\x89\x84\x844\xd8W\xde\xd8\xe5\x03\xa1\x93\xa9\xd3\xdf-\xb9\xadPXv\x91\x1a\x99\x86\xd8\x06xO\x93\x81r\\\xcb$C\xaf\xa7\xf1z\xf3Z\x92\xdf\x83\xbf\x98p\xd8m~h\xf9\x85\x1b\x89\xd8.\x8e\x1a\x85\xd8\xeeL\x0c\xc8\x99\x0c\xa2W\xddQ\xa3\xc5\x0f\xc8f\xc0\xef*\x98\xa8\x05\x1f\xaffU{\x86\xceY\x11[\x00H\x86P`\xaa\xa0\x97\x83\xcf\xd2h\xdf \xe2Z\x0d\xee\x01\x80\xa0\xc8\x96Yn\xaf'8z+\xd3X'G\x9a^@\xad\xf0YaT\x05\x13\x7f\xc7\x9a\x94	\x10&\x8aq5\xc3\x10\xa9\x9a\xcc\xb2\xa5\x8e\xe9\xb5\xd1}\xda\x9a\xd1Qk\xa6{\x1b,\xf4 \xf0E3@\xee\x15ho\xf8\x9b\xee\xdaI\x08>\xd9\x84\xb1\x1c\"\xeb{\xc7Z[\xda\xab\x8a\x0e\xd87\xf2\xd2\xb8\x889\x9f\x1d T\x00\x9a\x8bP\xc2\xcc\x08k\xbe\x9c\x90+\x8a\xe9\x95a\x99]{7{\xa7\x80\x01\xfc\xc3\x92\xc0Nr\xbf\xa2\xd1\xd4\x82\xddZQJ\xd6|\x7f'\x97\x8d\xb0Ff\xbe\xb01P-\x0c}\x84\xbdns\xbc*ed\x8cX-p\xe9\xf6\xf3\x0ecT\xa5\xc4\x19\x8c\xc4J\x88\xef\xf6\xd8\x92VZ\x1a\x9c\xc3\xf0\xbf\xf4\xb1A\xc49\x8a \xba\xee+\xdb\"\x9d\x84\xfd\xee\xbd!5\x83#\xe6\x0f\x9a\x0b\x8b\xd1'\x83\xea\xebA\xdd\x19c\xb0\x8e9?/u\xc5C&\xa8q\x97)+\xf5\xa8\x93\x97tvdU2\x0f\xe8\x99\xe2F2\xdfl\x15PBN\xf3I\xfb$a\x82\x1eq\x02\xd9.\x11\xf4\xa4\xa8\x9c\xf7\x91\x1bZ\x92R\x91/\xd0,|\xf9\xfe\xf6%\x89\x10\xfc\xa9\x9aY\x9a\xe5\x94.\xec+xX\xe0\xcc\x13\x16\x19\"\x04\x99\xc3\xe2\xf5/\x08t\xa0\x7f\x16\xef-\xe2\xd3\xfdk\xe2\xce\xe5\xc2%\xf4\xfb\xf1\x07\x89\x80a\xcdM\x8e\x86\x8c\xb2\xda\x91a\x13B\x82$\x83\xc05\xe1\xcf\xf2\x19\"^5Y\x9d\xcf\x9d\xb0\x0c\xc7\xf8,	\x8cL\xea=UX\x97\x8f6-\x1b,\xe6\xaf\xb5A\x0dRRC\xc1\xa7\xaaq5\xc00=\xe2v\xa3\x87\xde\xc7ke\xde\xd0\xeb%\xb5\x0e\xb9\x88p\x8565\xb9,\xb3\xe9\x9d\xe0\x08U\xf8\x8d'CR\x95HQ\xed\x7f\x87\xb0\xdf \x17\xf1\xa3\x8f\xd2\x0e\x8b\x03\xd65\xe4(\xcasN\xcb JZw\xdd\xc2k?p\xb2\xdf3\x92b\x03\xd9\x90\xe2\xa4L\xd1&o\x06g\xe0\xd1\x1f\xd9t\xafe\xe4x9\xfb\">y\xa3\x9e/,&\x10\xd6~\xce\xdeb\xa0<\xd72\xb3\x15S4R<y\xc6\xb9\xcd\x04\x18i\xf6y\n#\x15\x93\xe9\xa8\x8a\xea\x84\xed\xd5\xf4'\xb7*\x05\xbf\x01M\xd3*\x06\x97\x86\xa3\x12\x8d\x944l\xba\xfa\x17\x0eR|\xd0n\x89x\x93\x83!\xb9\xef\xefH\xcd\x0b\xb2\xa4\xec\x81\xbd\x05\xce\x1dA\x00\xae\xe8\xc7\xad\x16\x91}k\x03\xf9\x88\xb1\xab\xdb\xaa\xe0\xb1\x80B\xaa\xcflB\xfbBV /=\x10\xcf\xb2\xde\x0b\xa9\x8e\xab\xc3\x08\xbb\x15<\xdb~\xaf%$+M\x10\xcb\x81\x1a\x04u\x17!\xc8\xa5\xe7\xcax\xa4\x10\xcc\x89zX\xa0\x98\xaeQ\xe7\xa8\xcc\xc7\xa1~,|\x1f\xeas\x87\xc0u\x8e\xf9\x04\x9c\x9a\xad\x98\xf1\xd4\x15\x1b\xbcR\xf9\x93\x01\x92	\xf8\xc9;lp\xb2[&Y\xa0\xcd\xeaA\x03\x82\xa2a1\xb8\xe1pI\xca\xca\xa8\xc9V\x85X\x9es\xa7\x08-\xe1\xe1%\xa7\xdf\xd9\xdd\x96\xf6i\xc1\xd4Y\xe6s~uDH\"\xe5\xea\x9a\x87\x9c\"\xf6\xe4+\x0e\xc6\x16_\x19\xf2\x82.b\xc1y')\xef\xa8\x17f0\xc9\xee\x87\xbd\x90XP2`J\x1d*\x9bP\x1f\x95Y\x96\xf47\x02\xd4\xb9Z\xa2\x97.z\x93_\xbf\x860e\x8a]b\x85\x0c\x8a\x8dA\xb6\xc8\xad\x8bw\x9c\xfa\xea\xce\\\xcb\xd9\xb8\xb8\x17o\x1d\xf417\x80\xb8\xc2\x0c\x9c \xd8d\x9b\xaa\xea\xdb\xdd$K\xe5xM\x88,\xdaq@_[o\xc8\xb1\x1ar\x1b\x19\x05\xb6G\xf0(\xf3{\xf4%\x87\xcfx\xf4%p\xad\x86ho\x9f\x1e\x92\x06\xe6\xbf@8\xfbO\xbf`=\x97\x04\x9f\x885&)\x80^`i\x1d\x98\xc5\x14\xc7\xb8\xd9\xde\x96\x16q\xb6\xff\xb2\xe4\x0cQ\xc8\x84T\xc2\xa1\xafY\xe3#\xd2\x05\x0d\x93hw\xc1\xc6\xbc\x0c. \xea\x15=\xb4\x04Ir_hp\x91\x91'\xb6>BP\x0eu\xcf\xcf,Rd:\xd9xKp\xcc5:H\xa9	\x1f\xc8^\xb1\x16.\x14p\xf1rY\x0d\xd5\xfb	2\x81\xe6\xed\x1b\xac\xe9\x0dI\x87c\x13&c\x18\x0b\xa4ZfyL\xaa\n\x1f\xc7\x1e\xc5o\xa4i\x10\x95\x9d\xd7\xac\xdf\xb3\xc7\xe1i\xd62\\\xe9~m\xc9\x98\xd0\xa3\x8b\x84\xc5\xa1\xde^\xac\xd0~\x86I\x9d|\x90\xd2\xf0\xf7\xbdKV\xfcl\x08s(\xa6\x86al\x97\xbb\xe1wur\x97!\xf11\xc7;\xb0\xa8\x17\xecBt\xfb\x94\x95zQ\x18\x7f)\xdb\"\x8e3\x8e46\xdb\xfb3\x8b[9\x9b\x08\xd4+\x8c\xa40\xe0\xfb	'\xa6\x8a\xba}l\xb2\x14\xf9\xab\x81\xf9\xe9\x9d\xcf\x10\xf15\xa7\xc1\x98\xd7\x8aE\xf0\xd0\xa5\xf4:\xbb'^\x9c\x8co\xcdr2\x92\x95\x08\x19\xdb\xb2;\xe3\x8a\xa5U\xee6-b\x8a$\xa7R\x9b\xbb\xa6\xdaL\xcf2\x94\xfb\x05\xf04\x0d\xf9\xedlAzon\x07r~\xd6\xaei\xae\x19H\xdd\xf03\xef\xef\xba\xc7\x01\x8f\x98\xc5\xa6\xec\x84\xa5\xea\xaa\x01{5!\x07\xa1*!\xb7\xcf\xd9u\xc5Z\x92%,\xf9\xb2;\x84$\nX)F\xd9E\xcc\xd7\xd9q\x94nz}\xb3`.\xa05\xd0.\xf3nvI\x0e\xf2\x81W\xe6\xb3=\x03\x08N\x807\x87\xe4\x0c)\x803\xdf\x19\x98R\xd2O\x9ed\x91\xa0\xd0M\xccC\xb8y&\xed\xc5A\xdc\xf65K\xa7\x81LS\xf8#\x19D\x1cg\xf7\x8a\x05$\x19\x014O\xfb\xe7v\x99}%\x93e\xe7\xabj\xc2\xb1\x81\x0ba\xd5\xc9\x15\xd9]\xad\xb0#\xd3\xf5\xd5<\xaca\x80\xd3{\xf5\x83\x86-9\xe3\xc4\xa9\x91\xc6\xcd\xee\xb1\xbd\x1a\xb2\x05Pw{A]~\x05\x93M:\xbd\xb0$\x04f\x1f\x9e3\xfb\x95\xf9\xd1i\x05}\x1f\x8c\x01=v\xc8\xd9Y|wr8\xc1\xf3\\\x02\xd5\xd4\x04\x1a\x9b\xf4`IH_N\xd69\x00\x88\x8fe\xc5\xf6{95\x19\xad\xf5\xc0\xb02\xb6!\xa4\x87\x9f\x0e\x89\x95v\xf6HRy\x0c\x0fr\x96\x93<\xe6\x8f_\xe4\x80<\x07\x18d.OJ\x7fg\xb0G	,\xa7\xed\x1fNf:\xe6%Y\x05_m\xc7Y*/\xae9\xe9d5\x16\x05HO\xa5A^)\x15\x14V\x92\x8eB\xb3\xb7l\xe1\xd5o\xb5\xc8*\xbd\x85n'\xb7\xb8\x99\xdcd\x8f\xd2\x9b\x91\xbb?/\xb5\xda\xe3\xed\xbc\xe6\xf3\x1a\xf7e\x0e\xd5\xf4hj\xe0\xd9	\x16\x15\x03\xf9\xbb9MU}>c\xe5#\xfbH\xa2 \xd3\xc2\xf6n\x02<\xcaV='\xfa\n\xb8\xa9\x1c\x8f\xb5@\xd3\xd4\xb7l\x85\xc6\xed\xe0$\xc9\x1d\x90\xd1\x8d)I\xacT\x82\x1d\xdd\x88\xad\xaeT\x98EP\xdfp9Er\xb0\xb8%\xc7\xa6>nv\xa6\xffR\xe8\xaa4\xd8U\"\xb4;\x92\xc6t\x9e'\xc2Y\xd9\x90\xbe\n\xfcQ\x8eP\xc7\x9c\x916\xc1/V\xb2rb\x7f\xfa\xb6\x07\x91o\xbao*\xfb\n\x99\x11\x85\xbc\x99\xc1N\xe3\xcf\n\xc1rX\x99\xc7,C\xd2'\x84\x10\x8f\xe4\xbe\xf0\x7f\x1e\x9f)\x0c\x06\x8f+\nr\xda\xa0A\xb8\xcc\xefz8\x00\xc3\xa3\xea\xb5y\x07;\xfa\xa8\xf6\xe9\\\xbe\xa6\x01W\x18A5\xcb\x1c.a\x9f6T!W\xe1!\xde\x88\x169L.\xb9\xf8\xf0\xe7\x96\xf3\xf9r\xe8Y\xcc\xac\x9f\xd2OX\xbeq}[0\xf7\x81+%\x8dX\x7f%\xad\xa57K\x80\xdf\xd7Do'\x96\x11ht\x0b\xa3C\xc55\x99^\x14#\x06\x10\xd6\xa7\x91\xd2\xac&\x1d`\x95\xc4\xb3\x1c\x99.\x9f\xb1\xb2/g_\xb7\x80\\\xcd\xc9O\x95\x95)V \xccK\xd5\x9c\xcdFe\xca\xffP=_\xb8G\x02(\xa8g\xb9\xbc\xfa\x1a@\xd0\xf8\xed\x82\xfe\xe2g\xf0\xa8\xc3Hf\x90&\x159\xc2<\xdf\xb8|\x9cF\x91\x89\x0eN2\x08\x99\x8a\x18\xb5c\xf6{I\x92L\xf58\x8b\"E\xd4p\x15X5\xc8d7\xbfg\xe5\xa4\xdeb*\x94\xdf>\xceS\xd6\xe9\xa0\xd0\x83T\xf9JH\xe2\x17\xc4\x9fr&l\xb5\xfb5\xa7\x98\x89\xbb8)\xee\x16\x0f,X\x99\xcbqh\xef\xc5S\xd6\x0c\x8bu\x96\xecr\x96]] 3\xf5O\xb7\xbb}\x01<g\x87\x8d5\xb9\xb3\xce\x90\x8bn?=\x9eYl\xe4<\x19\xaeB\xc0\xaatlC\x0f\xf0\xc6\x17\xcb\xaa3\xe9\xc8\xd4\xab\xc9\x11Ku\xf1c\xbd\xc6	\xe9\x12\xc4\xd3\xf1\xfc\x92tBN\xb09%d\xd2\xea\x88\x1e\x87\x07\xba\x9e\xef3~\xfbB\xc53\xce\x8d\x1cz\x12\xaep\x0d'\xec[a\x13\xcc\xc0\xcd,\x9bC5\x9f]\xc4\xdf \xa2\xfd\xcdc\x16\xfb\xea{\x9e\xfb\x91\xc1\xa9\xb7\xec\xd3H?\xa5\xe6\x08V\xc3Az\xc9q\xd2\x93~\xfe%M\x1d\xa4\x13\xc9GN\xb2\xc02\xcd\xe8\x8f\xb2\xdcE\x0c\xbd\xc45?\x87W\xca\xceQ7-\xe7|oM\xa95\xb5\x14\xdc\x1b\xd7yl\xe6\xc9\xd1~\x99q\x90\xa3\x11\xe6\xb4b \x80a\xdbq\xc7\xacX\xbao\xa4'r\xda:\xfa\x01\x99vx\x849Tk	\x82\xf55\xd8~\xcc/\xb4\x888^k\xbf\xe7\xfb\x8a\xc7r\xd6\xd3\xc8!\xeb\xe4Lf\x1f\xabc\x96\x8f\n\xf6\xc1dN'\xbb\xc3+g\x1c\xc1\xb2 \x8cq\xcb\xce|.\x81?/\xcb\xafH\x85m\xafn0\x94Y:\xf78\xeb\"\x9d-\x0e\x07\x149{\x17\xb6Zx\x898\xf9q\x89M\x87z\xe5\x1bk)\x92\x98}\x92\x8ayz\x8a\xdb\x8c\x00\xd7\xf6\xd8\x8ft\xbf\xba\xc3\xaf\xeaxN\x86\xb8\x06(\x0d\xa9\xc6\xfd\x96z/\x80\xa6\xb0?\xcf!n\xce\x19J\x15\xb7\x12n\xdb=\xae=\xc1\xae\xa0\xe6\xb9<\xbc\"\xf9\x86SO\x0b\x9a)\x84\x0b'\xa0\x02\x83\xad\xefx\x8c\xc6\xb3sP\xf3\\\xa2T\x84\xab\xb2\x9cf\xf3:\x1a&\x85\x8azpc\x9f\xaf\xce\xb3\xef`\xd5\xac\xa5V\x12w\xd3\x8c\x84\x93\xbc\x12\xd5g9$\x9a\xb26Q\x97\xcf.8\\\x19\xf1,\x93\xe9\x16\xd8\xbbG+?\xbf\x81\x9cx\x88%lV\xee\xf1\xe2\x03\xb9\x10\xe2&\\\\\xaas\xf6\x98\x1a\xc9\x0f\x0e\xe9\xcf\xe0(\xd5\x05\xad\xc7\x11O}\xcer\xad\x1c\x1a-\xae\xcags<\xebg\xd7\xdf\xf0\xf0E\x91O\xb1\xcf)\xd9S\xa0=\xc4\x82\x12\xf7\xfc\xde\x91\"\x1f\x83T\xee\x18\x17\xa5.\"`pL\x88\x90\xa8\xd6=\xd2\x17\xcc~\x03Q\x9c\x03#\xe3)C\xc3.\x8bty\xf9-\x1a\xe26\xc9\xfd\x11\x04\xb6;\xc5t\xc7\xdf\x92\xf2\xd6<\x84l\x15\xc7e\x8eO\xceV\xf4\xb3\x1d-\xea\x94\xb4R\x16 \xf3\xcd\x0b\x00\xd3)\xea-$\"1]\x97MZ\x8e\x9c\xd5\xcd\xdd\xc2S\xc2~\xaeV\xe5\xb2\xc8\xfe\xa5)\x15OD\xac,D\xeci5\xfc\xf2\x8fl\xbe\xc3i?\xce]8\xf7~\xeb\x1b>\x17\xcd\xb2(\x05\x8a\xe3\xb2\x0d\xe2\x97\xf4\xcc\\\xcf\xc6G\xb6\xf0r\xc3s\x8a\x05\xed5\x05\x9e\xba\xb5\xad\xa1\xe4\xfa\xf9\x1e\xdc\x9f\xa3\x0d\xae\x1a\x86\xa1^|\xdea*\x12\xef\xa8\xd5\xc8q\x0c)\xa0\x19s\xf8\xaf\x83\xcb\xf2\x9d0#C\xec6T\xdb<\xc1Dv\xd8\x1d\xee1vc\x0e\x1b4w\x1c\xacc\xcb\xc2\xca\xd1\xdb\x13!\xe1\xd1-0lf\xedq\xe4^NN\xf8\xb5\x11\x1eh\x8c\xa1\x96b\xb2\x8f-\xbaJzf\xeb\xfeB\xfd_\x8a\xeeNP{\x0e\xab\xa3\x11\xf8\xe0\x10\x12\xa8X\xe9\x90\x91^\x19\x120\xe7\xec\xc6Gl'W\xbf\x1eS\x16Gw\x0b\x9b\xe3?\xd7d[\x89\x1f\x94\x13y\xd3\xeaL\x81y\x98{\xaa>\x85\xc7[\xdfG\x1f\x97\xc8q20L8\xf3\xe6\x1a\xefT\xacQ:\x12b\x04\xf0\x90\xdf\x1cR\x99\x1d\xe0\xc5\xf4 o\x17\xb1\xa6\x11\xfc&Q\xb2\xd3\n`FN[M\xb1\x0ce\xec\xea\x85\x06\xc7:\x9fQjm\xb3n\xe5\xb4\xdd\xe1I\x9d\x9aS\xf2r\xcc\xefP\x8a\xbd3<q\x89[WC\xea\xe0!\xcf\x8e\xc9\xe0d\xb4\xf8\xf0kq_\xc8B\xa9U)\x17\x0c\xa9!\xd9z}\x18ar\x81c\xf2\x0e\xdcI\xf2-V\xabLu\xdd\xedT\xe6\x07C`\xee_\xff\x0c\xac\xabU\x16\xd1F\xf7\xcb9\xb0k\xc0\xb7$\x85\x0d\x1b\x1d\x11\x93\xdel\xe8\x0d\xbd0\xcaYq.d\xaeL\xc6y\x01\xff\x9e\x87\x0e\x9d\xbf9\x1f\xe0DIr\xfcI!:\xa8\x87\xb9)'C\x1a\x1d\xc2\xb2t\xa1\xd3mX\xc2!\xbfK\x06b\x91o\xa3\xe1)\x9f\xc6\xfcaWK\n\xf0\xbf\x97\x94\xae6<\xc3\xd3\x84\xdfoYu\x96\xaa\xc0!\x8b\x8f\xbe~WtXl\xcd\xfd\"+\xb52g\x97-\x80\x08-\x01\xb6\xbf\x1c>eO7\x96\xf3T\xd1e7\xfc \xe8\xcfr\xb0*\x1d\xe3<P\x90hQ\xfd\x98\x87gkF#\xad\xce\x94\x87\xfb_\xd6\xf9\x1d\x1cr\xf4z\x15\xd1K\xacY\xf2\xa6\x1d\xca\xd5\xf0$(\xd6\xeb\x0e\xf0\xc0\xe9\xa7,\xd7\xf0`\xa0\xf1\xb3\x1f\xd8M\x949\xb8	@\xf9\x0c4\xb6\xcf\x99/\xfd\x92\x9d\xb7\xf8\xe7\x9cv\xd3\xb0.\xc9\x93\xf22\xda\xcao\x1ch\xec\x19\x13\xbd\x86\x03\xe5B\xc7y\x14C\xea\xee\x8e-\x159\\\xe6\xba\xdf\xc7\xc3AG\xf9\xae\x0f\xf6.\xbc\x9d\x83o\xb8w\x9a\xbb\xe6\xbc^N\xea5o\xf8\xc5\x0b^\x1d\xd7e\xcb<\xf4\xcb\xf3\xa1\x8e\xf5%9^\xd1\xf0\x86\x9e\xdf\x11\xe2\x99\x90K\x9d\xc0pB\xab;_\xc5~\xa8\xe7@\x99e\xa7U\xbe\xc0F\x8e\xd6\xecOr\x82\x81y\xbe\xe5,\x8f\x9c\xfev	ks\xb5\x1c\xfb>\xd4\x9b\xc8\x97	\xf9WRH\xdd\x87\xc9\xde\xf3T\x9b\x1f\xe3	\xec\xe21/\x08s~\x0b\x83\xf4\xd3\xf8E2\xdd\xdf\xeb\xff2\xb4\xbf\x85\x80\x9a\xfe\x9e\xea\xce\xcc\xe3g\xa2\xaf\xfb\x9b\\\xc7\x81\x1fi\xcb\xc6\xff\xf5\x0b\xd6\xfe\xee7\x1e\xfc\xdbW\xab\xfd\xf7\x1f\xe1\xa9\xe1\x7f\xbe\xfe\xf7\xbe\x92\xed\xef\xef\xe2\xaf_\xb2\xf0\x0f\x0e3\xfc\xdb\x81\x83\xff\xfe\xd3\xdd\x8b\xa9\xfe*\xa0\x7f\xf0\xcd\x0c\xffdC\xddC\x14\xffwE\xff?\xed\xe0_\xc9\xf6?:e!\x8b\xe77\"\xfc\x8f{\xc7\xff\xfe\x7f\x13\xff\xfb\xfb\xae\xe0\x11\xbc\x7f\xfb\xdc\xb3'==\xfe\xe7\xd8\x9a;8\xfay\xe5.\x87\x9a\xea\xaa\x1a~\xd2\xf3\xe7I\xcf\xff\n\x00\x00\xff\xffPK\x07\x082P\x95\xedg%\x00\x00\x98P\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00s\x93WX\x8d|\xd8oR\x04\x00\x00\xcf\x0e\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\x00\x00\x00\x00application.goUT\x05\x00\x01\x9b\xe3\xd8ePK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x1a\x92WX\xdd\x98F\x95\x94\x05\x00\x00\xe4\x13\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\x97\x04\x00\x00application.tomlUT\x05\x00\x01\x14\xe1\xd8ePK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x92WX2P\x95\xedg%\x00\x00\x98P\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81r\n\x00\x00statik/statik.goUT\x05\x00\x01\"\xe1\xd8ePK\x05\x06\x00\x00\x00\x00\x03\x00\x03\x00\xd3\x00\x00\x00 0\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x04OR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00application.tomlUT\x05\x00\x01\xb9\x97\xd4j\xb4Y\xfdo\xdb\xc6\xf9\xff\x99\xfa+\xee\xcb/\x82n\x80%\xf9-K\xd1J\x02\\'\x99\x83\xb5\xa9\x11\x1b\x03\x06\xcb0N\xe4I\xba\x88:\n\xe4\xc9/\x15\x04\xcc\xd2\xd2\xc6[\xb3\x16k\x9b4i\x96b\xed\xbaf\xc9\x9aek\x81&X_\xfe\x18Vv\xfd\xd3\xfe\x85\xe1\xb9;\x92G\x8a\xb2\x9c\x0c\xd3\x0f&y\xf7\xbc|\xeey\xbb\xbb\xc7\x1b\x17v-\xe2l\xe6\x0c\x1bs\xec\x13\x8e\xcah#g\x18=\xe47\x89\xf82\xd7\xd6\xae\x1c}vo\xf4\xf9\xc3`\xf8 \x18<\x0d\x86\xd7\xcd\x19\x14\xfe<\xecQ\xbe\xa7\xc8`\x9cZ.S\x9fgf\x17l\x8d\xd4\xed\xf2Nw\x82\xc4B\x93\xb7\x1d\x13\xf5g\x92\xaa\x0f\xef~||\xf3\xbd\xe3\xdb\xef\x8e\x1e\xdd\xc9\x84q\x92\xfaWi\x9brb\x87(b\xf5'K\x9d\x00%\x18\xfc9\x18\xde\x0e\x86_\x06\xc3\xebYHt(\x02Il\x89+\x17\xb6	\xe3\x9a5b('J\x9d\x80$S{\xf8;\x01\x85\x06 \x85\xe2\xb4\x9a\x13\x86{F\x1b\xa4\xbc1\xc9\x1d\xa7Drt\xf0tt\xedI&\x86)VX\xf5\xe86uH\x83(s\xc48&\xc8\x9c`\x8bI\xaaS\xfa\xa5\xfaH\xff\x98\x134\xfd\xa7T\xac\x9b+\x13\xc4d\xe5i\x1fh\xcaO\x92:\xc1\x02\x90.\x83/D\xec\xc6	\x19\xfdb\x14k-\xca\xcc\x99\xd8\x05-\xca\x92V\x88Q\x8c\x8b\xd4to\xe6\x8c\x16\xd9\x03\x0eY\xa2L\xb9\xf8\xd1\xbb7L1\xe9\xbb\x1e\x8f&{\x88\xe16\x01\x99\x11\x15\xfaI\xb0\xff\xcf`p\x10\xec\xbf\x1d\xec?\xf8\xa99\x83\xb0o\x11fS\xd6@e\xc4\xbd.	\x03>d\xbd\xec&\\%~\x99<\x9b9\xc3\xedp\xea2\xec\x00\xb8\xcd\\\xce\x90u\xb5\xb0\xd6\xa2\x9d\xcd\x9cax\xee\x0e*\xa3\x85xb\xd9u\xbam\xe6\xc3\xdce7\x16\x8f\xd4\xfa@\xb7\x98\x03(\xfa\xaf\x8c6\xb4\x85\x03\xc9\xc5\xaeG\x1b\x98\xe1l\x92\xf4\xaa\x81c\x89s\x8f\xd6\xba<\x92\x0c\x1c\xa3\x7f\xdc;\xfc\xf5\xe7b~}\xaf3\xae4\x18|/\xaa\xd5-Ar\xa1C}\xd7&\x97\xbb\xed\x1a\xf1B\x11\xc1\xe0~0|?\x18|\xfb\xefon\x04\xc3\x83\xc3\x0f\x1e\x9b3(\x1e\x0d\x86\xdf\xa8Q\x90\xb0\xb4\x83[`\xc6\x84\x12X\xd9\xdb7\x7f\xfc\xcb\x9d\xe37\xff \xa8^\xe7M\xe2\xedP?\x01\xf5\xe8\xb3{\x87\xc3/\x8f\xee~<z\xfb\xa6\xa0Z\xe1mg.\xa4\x08\xa9V\xd6_{u.\x9a>O|N\x19\x06'\xcdE\xd3?\xde\xffb\xf4\xe8\xce\xe8\xda\xf5\x98n>K\xcc|\x96\x98\xf9q1\x92\xee\xe7\x84_tpje\xa3wn\x8e\xbe\xbb\x15\x0c?\x08\x86\x7f\x0d\x06\x8fM-F.\xe26u(\xf1\x0bk\x1c\xf3\xae\x08\x89f'\xe6\\Y=c\x0b\xb9\x98sl\xb5\xa4\xb8%\xf1\xae&:\x9e[\xa7\x0e\x84\xec\x86\x19\x0co\x05\xc3/@\xcf\xe0S\xe1\x86\x87g \xd5\x12\xe3\xc2\x110\x9e\x05By\x16\xe4r\xca\x1d\x12\xc2H9WI\x0d\x07\x85\xc8\x03\x85\xc7\xedr\x872\xa2\xf8\xf6\x07\"\xe9n\x07\xfb\x1f\xaay\xcbe\x9c0\xee\x8b\xf9\xd1\x9b\xd7F\x8f\x9e\xaa\x19\x8e\x1b\xa9\x88{<\x01\xe6R\x8d:\x94\xef\x01\x13\xd3rD\xf2}\x12\x0c\xdf\x83\x122|3\x18|\xaaD\x93z\x9dX<\xa6\x1a\xfd\xf6\xe9\xe1\xbd\xbbj\x922N\xbcm\xec\x84\x93?|\xfb\xfd\xd1\xfb\xf7\xa1\"\xdey?\xc1\xbf\xc5i\x9bh\xfc\x87\xb7\x07\xc77\xdf\x0b1\xaa\xa4\xbf\xe8zm\x7f3	M\x94\x13\xaf\x8d\x1d3g\x18\xf5(<\xca\xc8\x84\x01\"\x8d\xee\x03\xdd|\xce0\xb0X\x1d%`\xa2\xd9\x9ca\x88\xe2\xa9\xa0\x0b\x06\xe6n\xc1\x81MH\xa8c\xc7'\xd3\xd5\xab\x84\x1b\xd3\xaf\x8d\xeb0\xe6\xa6\xc1\xc0i\x1cP\x0e\xa7\xc3\x882z\x0cHbF\x872;\x0d\x8a\x9b\x0de\x03\x12z3g4	\xb6! }\x85a#g\x98\xc1\xfe@\xa4\xc5\xfe\xef\xe4\xe3\x03\xf9\xf8X>\x1e\xc8\xc7\xdf\xe5\xe3;\xf1\x18\xfcF>\x0e\xe4\xe3\x1dS\x15~@\xed\x87\xb9^Ff\xb0\xff\xc7`\xff\xd1\x0f\xff\xbai\xe6\x0c\xeeZ\xe1\x8c\xe6*\x832\x9b\xecj\xc3\xe0R\xb2\xdbq=\x1e\n\x12\xbb\x88\xe1\xe1\x1d\xf5\x1d\x017T]\x9bA\xe2e^\xa2\xc8\x19b\xad\x85K\x96\xcb \xeej\xd8'[]\xcf\x89x\xe3_\x19\x99\xc5\x16n\xd3\x8e\xe7\x16\xc1ld\x97\x13\xe6S\x97%\xc9\x14\xb0\xc2\xd5NC3\xee\x96M,\n\xc4[V\x13{\xd8\xe2\xc4\x03\xaa\x1f\x9e\xdc8\xfc\xf0\xf7f\x04\xe3\xbcku\xdb\x84q\x80\xe2s\xccl\xec\xb8\x8c$m`\xd8\xa4\x8e\xbb\x0e\xdf\xf2\xf9\x9eC\"\xa7iU'\\\xb5\x99:\x91\x01\x1e\x07\xeb[\x07`\xb8*\xe2Q\x08\x13g%_\x99L`\xb0<\xda\x89l\xab\x8c\xabhQj\x942\x08\x95\xad\x90%\xdc\xce\x85yWqC\xed! \xb5\xed\xdaP\nL\xe62\x11\xcc>}\x03\xbe\xcf\xce\x86\x89_F\xe6\x19?\x7f\xc6\x8e\x0d\xb3\xec:\x0e\xb1B\x01\xee\x0e#\xb6\x8a`x\x05!m\xea\xfb\xb0-\x96\x91\xa9^c\xeeK\xecj\xcc\\s0k\x01<3/\xeb\xb8@\xb8\xde\xf4\x88\xdft\x1d\x1bHRC\x05\xdf\xf7`8\xdc]\xcah\xa3\x87\xda\x14\x0e\xc6s\xe7fggP\x1d\n\x948\xdb5i\xa3I<8|\xa2\x88\xe4\xec\xec\xac\xfe\x9d\xa0w\xdc\x1dA.\xc4\xab}J\x13\xff\xe2\xd9\xa9\xe2\xcf\xcd\x9eJ|\xd6\xaa&.ja\xfa\xa2N\xa75sQ\xe7f\xa7\x8a\xff\xd9s/j\xd2\x9a^\x84%\x9d\xac\xf4\xdc\xec,z^G-Nw\xd4\xe2s\xaf\xc9oQ6aY\xd9J'D\xd3$\xe20M`\xfb\xc5\xb2\xf6\xec1\x8ew\xd5v\xd5\xe5b\x9b\xf09\x16\xf7\x04`.\xf92\x9f\x90\xe5`\xdf/WMu\x92\xf2\xabf\x05h-\xc7\xf5\xd5\x01\xc8,\x15\x15\xb1\x98	\xb7\x14!\xa5\xb9\x80\xa8]\xae\x9a\xbd^a\x89YM\xd7\xeb\xf7\xabf\xa5\xd7+\xac(\xaa~\xbfTl.T\xcc8|%\xc6\xc2\x92\xc7\xa9\xe5\x88\xc3\x96\x02&\xe4-F\x80\xa8\x9f\x17e*\xcf\xdc\xbc\xd5\xc4\xacA\xa4d\xb8\x17\xf4\xfb\xbd^\xe1\x15l7\xa4\xfc\xc5J	Kq\xbd\xde\x0e\xe5MTX\x86U\xf5\xfb\x91\xb0^\xaf\x00\xc8z=\xc2\xec~_\xacC-\x11\xb4\x16\x15\xb7\x84\x99\x89\xb3\xf0\x1aV.\x8c\xcc\x08\x9c6\xdd\x96\xc2\"\x83\xc1hQ\x0e\xe7\x8c\x93\x84\x15\xae\xd0Z\xcdU2\x0dyB\n\x0dn\xd3\xed\x08\xba'\xc8\xaaf%ct\xcb!u\x0eSBc\x16\x81G\x1bM\x8dB!\x13*\xd5\xe9\xe7\x04\x95H8\xa0\\5\xf3\xf9\x1a\xb6Z\x0d\xcf\xed2\xfb%\xf4\xff\xf5\xfa\xe2\xe2\xd9\xc5\x97\xffKL\x9atq,\xde\xe5/\xa1\x17\x96\xea\xb0\xb1*h/\xbc\x9c\x8d<:.=3\xf2\x85\x85\x85\xb9\x85\xf9\xff	\xf2\x08T\x06\xea)\x91\xb0*sO\x85B\x9c\x0d	\x04\xee\x8eJ\xcdd\xe8\x02T\xd3\x90\x1a\xa6\xab(\x9c'\x1cSGi\x9a\xa0\xca\x12\x17\xf4X\xdb\x98:\xc5\x0dG\xf3\xb91\xa00\n\xf6\xa5\xed\x06\xf2=K&\x1f\x9c\xce \x01\x91\xe3bh;\x94\xab\xa6\x83\xdf\xd8\x8b\x0c\xa5\x8b\x9c\x9f R\x8b\x17\xec\xd0\x06\xcbSN\xda\xfeK\xc8\"p\x85y\x19\xe5\xf3W\xbb>\xa7\xf5\xbd\xbc\xbaeES\xa0\xc6\xef`V\xb9\xec\xa2\xf3\x98\xe3RQ|%\xbcsz\xe3\x15V\x89\xe7C\xc3#\xb4\xa22\xe3x\x1eu\x14!J8/\xb4'\xd2\x1c\xa8I\xe2]\x7f\\\x92t	\xc8\xc9{\xa4\x86=\x9f\xa4CXqF\xbeK\xb0\xcb\xc9\xad\xb0tW\xcd\x8a\xecy\xc4u\x03jv\xd4\x1c\xe9\xf7\xe3\x89\x13d\xac\xffj\xf5BR\x02\xb4ON\xc9\xbc\xb2\x9ad]\xe9\x9c\x92qi}}i\xf9\x17If\xd9\x13\x88\x04d\x80\xef\xd6\xf4\xd5\xafu\x88\xa5\xd3F\xf6\x8f\xfb	\xc9\xecS\xe3i\xa3k\"U\x0e\xc7\xba+\xa5\xce\xd4\xbdL1\x01\xf0\x8e\x82\x92\x0c\xca\xe91\xa9u\x03N\n\xc5\xec\xb8\x88.\xdb',L)P\xe8l'2V2\x8a\x9d\x14~U9d&\xcax\xe6\"\xc8\xa48\xb5\x8d\x97\x8a6\xaf\x94l\xbbR\xea\xe8\x93\x17D\xc7B\x99\xa5S)A\xbaN\xf2\xa5\xde\xb0P\x89\xadI\xba\xa4\xda\x1b\xfd\xfe\x89B\xf4\x96\xc6\xb8\x10	g\x9d\xb6cO\xd9\xcf\xe0\"\xad\xaf\xf4\xec.\x8a\xba\x01\xb2\x86d\xcd\x9d\xe0<\xa5Z\xb9F\x0f\xf5\xb1\x1a4\xfe7\xd3\x89\xd9zz\xbdp\x95\x85u\xb8\xc4f\xe6\xb2j\x8e\xa5\xe1N(Y\xc9\x8c\xd5\x15\xe0F\xa6\xf8\x8e\x83\xf7\x94l\x9d|Y\xb5\xdc\xfa\xfd\x8cu\x82EJ\x1d\x1d\xfe\xeb\x12d\xe4\xe8\xc82\x19G\xd8\xb0^\x8a\x8d\xc0<\xda\x7fh\n\x1b%\x02\xadN=X\xf1\xd1\xfeC\x15W\xc2\xaa\xe6\xe1\xe3\xaf2\x88w0'^\xd5\xac\x1c>\xfe*A}\xfc\xc9\xfd,j\xca\xec\xaaY9\xfe\xe4~\x92\xf8\xa3\xaf3\x88y\xb3\xcbl!\xfc\xf8\xa3\xaf\x13\xf4\xa3k\x07\x19\xf4\x8e:8\x8e\xae\x1d$\xa5\xdfz+\x83\xda\xc6^\x8b\x11\x1f\xea\xc8\xf1\xad\xb7\"\x86\xb4\xc5`\x7f\x10\xc6Z\x8a\x1a\xba)s\xc9\xcb&\x94\x1d\xf1\xa2\xab>O\xea\x84\xf9d\x9c\xc7\x96\x13U\xb3\xa2Ht\xaeu\x8fZ\xad\xbd\x0cM\\L\xc06&^t\x9eW\xb0\x83\x99\x95\xa1\xa9&'\xaafE\x91\xe8\\+\x04;\xf0\x1f\x811|M1\x01{\x9ex\x99l\x1d\xd7J]\x87\x18\x8e\xc3\x9b\xbb\x16DwW\x15`\xedL\xd6\x85\xda\xcb\xb0\xcam8\x15\xc9\xe5:\xb4R\xc2\xa8\xe9\x91\xba<\x84\xbdJY+\xeb~\x86S\x05\xd6r\xbb\x0c\\\xdf\xeb\x15\x96\xe1\xb5\xdf\x8f\x0eL\x0e\xcd\xc8\x83K\xd0\xd3K!O_/E\xdf\x0f\xf0s\\sH\xa5\xc4!\xb7+%\xee\xc1ke\x0d\xdaV\xa5\"o\x8a\xaf+\xe2\x1fh\xd1\xa7\xc0 \xbf\x8a@_\x0cyk\xae\xbd7f\x0c9Z**=\x89\xab\xabf\x1b\x10\xc4\xed\xc9\xf6\x11\x88`\xe1\x18d\x01R\x1b\xec!\xa1\xf5\xfb\xfaXd# \x03\x84\xe3\x16\xd2\xbb\x82\xea\xb4\x08`\xff\xef\xfc\xeb\xcbp\x88B\xf0\xaf\xbeJ	\xfe\"h\xef)8\x985\x04\x9c\x92\\o\x9bp\x8c\xa0\xf9\xe8\x13^\xae\x9a]^\xcf\xbf\x08\x16\x15\xe3\xd0u+W\xcdmJv\xa0\x99Z5\x91\xaa\xda\xe5\xaa\xb9Cm\xde,\xdbd\x9bZ$/>f\x10e\x94S\xec\xe4}\x0b;\xa4<\x07r\xc4\xff<`\x91Q\x05\x0fG<\xb8}\xa3\xc2Z\xdca\xec\xf7K\x0ee-\xe4\x11\x07v\xe4h\xa2jj\xf1\xa6bM\\\xb9SB`;\x06&P\x07\xd6\x0c?\x04m\xa9(\x17\x9c\xe1\xdfX\x8clR\x82\x1c\xf1\x16_5\x84\xd6RQ\x0e\x87\"#\xbeK\xa2\xc7\x99\xe6\x8e`$\x98JE\x15K\xe0\x98\x0c\xaf&{\xa2\xd1-`,s;\x11]x\x11\xd1\xa7\xc2\xa3 \x86f\x06*\"8\x16\xe2\x06\xf1\xa3\xac\xd3,\x10\xee\xd7Q\xb2w<\xb2M\xdd.4k\xcd\x12\x8e\xc5\xaa\xe1\xaa\x99\x15\xde\xa3\x83\x1b\xc1\xfe\x13\xc8{!\x9a\x91]\xae\x9am\xba\x0c\x18\xce\xe6?\xfc\xdb\x9f\"\xfet\xa4\x8b\xa6\x8c\xa8\x05Z\x8b7Y^j@\x02W\x10\xf1\x7f\xc1\xc3'\xd7\xf5ujM\xe0l\xae\xc3\xbb\x0f$c\xc4\x95\x86\x10\xb5\xdd\x04\x0c\xd9\xc7\xcb(\xcab\\\xda\xfe\x97\xd8\xe9\x92\xa4\xbdE\xdf5\xa3\x96\x8b\xf1l\xae\xff\x0c\x00PK\x07\x08 A\xf4N8\x0b\x00\x00P$\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00mOR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00default.cssUT\x05\x00\x01\x7f\x98\xd4j\x9cV\xcdn\xe36\x10>[O1@Pd\x93F\x8a\x9dd]G\x02\n,\xb6\xc7b\x0fE\xef\x0bJ\x1cIl(R \xa98\xae\x91w/\xf8#\x89\xb2\xe3\xa2[\x08\x88\x15q~\xbf\x99\xf98\xf7\xb7\xf0\x1b\xd6d\xe0\x06\xb49p\xd4-\xa2\x81Z*0-BG\xd4\xcb\xd0\x83\xac\x81\xf4=g\x151L\x8a\xcc\xc8\x8e\xc3\xed}\x92d\xbd\x925\xe3\xa8\xe1\x98\xac\xd2\xb4\x94\x8a\xa2\xca\xe1\x8a\xee\xe8\x8e\xee\n\xfb\xad\x1b\x0c\xd2\x1c\xae\xb6\xa5}\xdc'RU(L\x0eW\x8fd\x8b\xe4s\x91\xacj)LZ\x93\x8e\xf1C\x0e\x9a\x08\x9djT\xac.\x92\x15g\x02\xd3\x16Y\xd3\x9a\x1c6\xd9\xb6H\xdec\xb7\xed\xa3\xf5\xec\xfd\xa6\xa54Fv9<\xf4o\xa0%g\x14^\x89\xfa4\xfa\xbb)\x92UGT\xc3D\x0e\x0f\xd8\xc1\x1a6\xd8\x9d\x9a{\x82\xe3,\xb5\xc9>;9\xfb\xe3\x05\x99N\x1dH\xa9\x90i\xd5\x12\xd1\xa0\x95/I\xf5\xd2(9\x08\x9a\x83\x90\x02\x8b1\xa2\x1c\xd6E\xb2\xea	\xa5L4\xee\x9f\x857\xa2\x0c\xab8\xce\x19\xe4\xb09	\xddgv3YL\x15\xa1l\xd09<\xf5oE\xb2\x92\xaf\xa8j.\xf79\xb4\x8cR\x14\x17\x1c\xfc\n\x94\xbd\xc2\xcf\xee\xef\x0c\x97\x91\xfd\xbf8|O\x92\xfb[\xf8\x9d\x1c\xe4`|\xad\x95\xdc[m\xcat\xcf\xc9!\x87\x9a\xa3\x8d\xc1\xfe\xa4{E\xfa\x1c\xec\xdf\"Y5\xf6\xdd\x81;\xe7>a\xad\xe4>UX\x12\xa5]\xe2N\x9b2\x85\x95\xed\xac\x1c*\xc9\x87N\xa4\n_Qi\xf4*\xfe\xdb%\xdf9l\xec\xb3\xb5\x0e.\x98\x1bc\x9a\x0b\xd9\"\xa1\xb6\xb5\xacQ\xd7|\xfb\xd0b\xa5\xe4tF\x9bcm\x1c\xd6\x17\xfa)\xa4\x17\xe4f\xf3z(\xbf\xc7.*\xc9\xa5\xca\x03\xc4n\"n\xc6\xae\xd7\xeco\xcc!\xdb9\xdd\xd0z\xa9\xf2\xc1L\x06\xefo\xe1\x0fV\x96R\x84J\xf8\xf7\xa3\x1d\xa6\xb8\xf9N\xdb=M+)\x0c\xbe\x99\x1c\xae\xbfI\xd5\x11~]\x9c\x838O\xd7n\x8c\xdf;\xf8n\xd3:mp\xefc\xf6j\xfd\xec\x195m\\c\xaf\xee\xb2\xf8/\xfa\xa1\x8c\xe7\xcayNj\x83*\x82\xf0\xaa\xae--\xb8\xbc,\x87\xf8pB\x9a7\xc5\xc7\xd5\xbcP&G}\x86\xb0\xc0e\xac\x1a1%\x9c5\"e\x06;\xedQJ\xb5!\xca8\xf2\xfak\xd0\x86\xd5\x87t\x8a`q\xbe\xd0\x0c\xe5\x98?\xd9\xf8\xe2z]\xd5O\xf6\xf9\xa0&g^\xbc\xad\x93\xcf\xd6^\xc7\xc4\xc4\x8f\xdb\xb1~.\x15\xd65\x16\xb8\x8e\xbc\xa5c\x81\xd6\xeb\x9f\x02E\xa0\xd2R\x10\x0e\xc7	\x9d\x89\x9f\xb4!f\xd0\x8bqk\x14\xa3\xd3\x10=XVt\xf3\xbc\xb2\xdfS\x83]\xcf\x89\xc1\xd4\xcf\xa9\xce\x81\x0cF\xc2\xa6V\xb1\xbd\xff5\x0f\x11\x9bA\x1fS\xf3z\x1c\x8b/%\xe3\xcc0Kv\x82\x02\xf6LK\x8a\xda\x17\x94Lg\x94\x9f+\xc7\xc7\xe6\x02\x0f\x9c\x88\xd1\x85\x95p3\xccm?y\xff\x00\xd4p6R\xde\xd4\xedr0#(?D\xac\x81(\xc6+o\x8a\xc1Z\x80\xe3\xa56\x9bJ\xed\x8b\x18O\xc2\x17c\x14+\x073\x82W3\x85p\x84\xc0\\W\xf4\xf9q\xb7\xc1\x02\xde\x93lO\xdcHNG\x1b\xdc\xd6\xf4\xd9\x1f1A#\xa5\x0d>\xd3\xa7\xd2\x9d\x98v\x10t\xa1V=\x93\xcdz\xed\x0e\xb9m\xdf\xe8\x88>\xef0\x1cQ\xa2^\x04j\x1d\x9dn\xcb\x87\x9a<ZE;\xc1\x7f\x1e\xfa\xa9\xde\xc6\x90\xea\xe5\x0e2\x8a5\n\x8dw\x90\x19\xc5\xaa\x97\xc3\x1dd%\xe1DT\xf6S\x8b\x84\xdb@\xce\xae\xd4Gw\xa5\x8e\xe1y\x9e\x99g\x03\xb2\xa7\x11c\xef\x07\x8e\xb0\x009B(\xf8?\x95\x88\x80\xf2q\x9d\n\xcc\x99\x8d\xf1\x9e\x9b\x98\x10\x1d\xf3X\n\xcc\xd09pZ\x85\xba\x95\x9c\x06\x84Z\xd6\xb4K:\x0dQ_\xec~.\xf7K\x85\x90D\xe8\x9a\xaf\x92s\x7f\xd7z\x07%\xa1\xcd\x85\x85\xa6\x1a\x94Ba\xbeZ|\xcf\xf7\x19\x0f~\xcc\x00\xbf,n\xc4\x98\xba?\xacJX\xa62\xb9\x17H\xe7\x08\xd2P\xcf\x93\xab1R\xe8\x98\xd6L8\xaa\x94=\xa9\x989\xe4\x10\xf6\xcc\xfb[\xf8F^YC\xe6\x04\x8d\xac`\xe0p<\xbfF/\xecA\xd9\xcc\x96\x9ci\xe3\xf7\xc7iO\x9c3q\x88:\xf3Y%\x07a~\x84&O@z\x18\xc7:2\x97\xe7%\xd62\x8ct\xb8\xb9\xae?]\xdbFYH\x85\xfb6\x12\xbaqBI\xd6\x93\x86	\x8f\xc4\x07d\x15\xed|gw\x97\xdd\x96P\x9d\xae\xdfErb\xd5\xbeG\x94\xb3\xc8\x1a\xde\x17\x01d\xbd\xc2W&\xed-\x05asN7gB\x02\xdf\xcc,\xe0\xce\x93\x8c	\x8ao`H\x19/\xde\xb6K8\xe95\xba\x8d\xd1\xbdEk\xcdtk\x06\xdd\xf6\x0e\xc6\xd7\xb8\xd3&.\xbe\xb8\xc3\x7fH\xbf+\xbb\xb9\xf8\x95#\x07\x8e\xb5)\x92\xf7\xe4\x9f\x01\x00PK\x07\x08\xca7\xf8\xa7\xc5\x04\x00\x00\x96\x0d\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x04OR] A\xf4N8\x0b\x00\x00P$\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00application.tomlUT\x05\x00\x01\xb9\x97\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00mOR]\xca7\xf8\xa7\xc5\x04\x00\x00\x96\x0d\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x7f\x0b\x00\x00default.cssUT\x05\x00\x01\x7f\x98\xd4jPK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00\x89\x00\x00\x00\x86\x10\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestStylesheet(t *testing.T) {
+	application, err := New("", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stylesheet, err := Stylesheet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	classes := []string{application.Html.Collection.Owned, application.Html.Collection.Missing}
+
+	for _, snippet := range application.Html.Format.Snippets() {
+		for _, match := range regexp.MustCompile(`class="([^"{]*)"`).FindAllStringSubmatch(snippet.Text, -1) {
+			classes = append(classes, strings.Fields(match[1])...)
+		}
+	}
+
+	for _, class := range classes {
+		if !regexp.MustCompile(`\.` + regexp.QuoteMeta(class) + `\b`).Match(stylesheet) {
+			t.Errorf(".%s: no rule in default.css", class)
+		}
+	}
+}
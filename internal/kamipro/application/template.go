@@ -0,0 +1,176 @@
+package application
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	SyntaxAuto     = "auto"
+	SyntaxTemplate = "template"
+	SyntaxPrintf   = "printf"
+)
+
+var positional = map[string][]string{
+	"headline":                          {".Headline"},
+	"Toc.item":                          {".Anchor", ".Headline", ".Count"},
+	"Index.item":                        {".Link", ".Sheet", ".Rarity", ".Count"},
+	"Pagination.start":                  {".Page", ".Pages"},
+	"Pagination.previous":               {".Link"},
+	"Pagination.next":                   {".Link"},
+	"Article.start":                     {".Name"},
+	"Article.Main.Profile.Detail.icon1": {".Icon"},
+	"Article.Main.Profile.Detail.personal.status":  {".Attribute", ".Type", ".Hp", ".Attack"},
+	"Article.Main.Profile.Detail.personal.profile": {".Profile"},
+	"Article.Main.Profile.Ability.content":         {".Ability.Name", ".Ability.Effect", ".Ability.Interval", ".Ability.EffectTime"},
+	"Article.Main.Profile.Episode.content":         {".Episode.Title", ".Episode.Tag", ".Episode.Contents", ".Episode.Outline"},
+}
+
+type Snippet struct {
+	Name string
+	Text string
+}
+
+func (f Format) Snippets() []Snippet {
+	var snippets []Snippet
+
+	walk("", reflect.ValueOf(f), func(name string, text string) {
+		snippets = append(snippets, Snippet{Name: name, Text: text})
+	})
+
+	return snippets
+}
+
+func walk(prefix string, v reflect.Value, fn func(name string, text string)) {
+	name := func(key string) string {
+		if len(prefix) == 0 {
+			return key
+		}
+
+		return prefix + "." + key
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		fn(prefix, v.String())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			key := strings.Split(field.Tag.Get("toml"), ",")[0]
+			if !field.IsExported() || len(key) == 0 || key == "syntax" {
+				continue
+			}
+
+			walk(name(key), v.Field(i), fn)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		for _, k := range keys {
+			walk(name(k.String()), v.MapIndex(k), fn)
+		}
+	}
+}
+
+func (f Format) Templates() (*template.Template, error) {
+	templates := template.New("Html.Format")
+
+	for _, snippet := range f.Snippets() {
+		_, err := snippet.parse(templates, f.Syntax)
+		if err != nil {
+			return nil, fmt.Errorf("Html.Format.%s: %w", snippet.Name, err)
+		}
+	}
+
+	return templates, nil
+}
+
+func (s Snippet) parse(templates *template.Template, syntax string) (*template.Template, error) {
+	text, err := s.convert(syntax)
+	if err != nil {
+		return nil, err
+	}
+
+	return templates.New(s.Name).Parse(text)
+}
+
+func (s Snippet) convert(syntax string) (string, error) {
+	switch syntax {
+	case SyntaxTemplate:
+		return s.Text, nil
+	case SyntaxPrintf:
+	default:
+		if strings.Contains(s.Text, "{{") {
+			return s.Text, nil
+		}
+	}
+
+	fields := s.fields()
+	if len(fields) == 0 {
+		return strings.ReplaceAll(s.Text, "{{", `{{"{{"}}`), nil
+	}
+
+	return printf(s.Text, fields)
+}
+
+func (s Snippet) fields() []string {
+	if strings.HasPrefix(s.Name, "threshold.") {
+		return []string{".Value"}
+	}
+
+	return positional[s.Name]
+}
+
+func printf(format string, fields []string) (string, error) {
+	var sb strings.Builder
+
+	index := 0
+
+	for i := 0; i < len(format); i++ {
+		switch {
+		case strings.HasPrefix(format[i:], "{{"):
+			sb.WriteString(`{{"{{"}}`)
+			i++
+		case format[i] == '%':
+			j := i + 1
+			for j < len(format) && strings.IndexByte("+-# 0123456789.", format[j]) >= 0 {
+				j++
+			}
+
+			if j >= len(format) {
+				return "", fmt.Errorf("incomplete placeholder %q", format[i:])
+			}
+
+			verb := format[i : j+1]
+			i = j
+
+			if verb == "%%" {
+				sb.WriteByte('%')
+				continue
+			}
+
+			if index >= len(fields) {
+				return "", fmt.Errorf("too many placeholders, at most %d allowed", len(fields))
+			}
+
+			if verb == "%s" || verb == "%v" {
+				sb.WriteString("{{" + fields[index] + "}}")
+			} else {
+				sb.WriteString(fmt.Sprintf("{{printf %q %s}}", verb, fields[index]))
+			}
+
+			index++
+		default:
+			sb.WriteByte(format[i])
+		}
+	}
+
+	return sb.String(), nil
+}
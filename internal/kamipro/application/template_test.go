@@ -0,0 +1,97 @@
+package application
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvertPositional(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"headline", "<h3>%s</h3>", "<h3>{{.Headline}}</h3>"},
+		{"Toc.item", "<li><a href=\"%s\">%s</a>%d</li>", "<li><a href=\"{{.Anchor}}\">{{.Headline}}</a>{{printf \"%d\" .Count}}</li>"},
+		{"Index.item", "%s %s %s %s", "{{.Link}} {{.Sheet}} {{.Rarity}} {{.Count}}"},
+		{"Pagination.start", "%d / %d", "{{printf \"%d\" .Page}} / {{printf \"%d\" .Pages}}"},
+		{"Pagination.previous", "<a href=\"%s\">", "<a href=\"{{.Link}}\">"},
+		{"Pagination.next", "<a href=\"%s\">", "<a href=\"{{.Link}}\">"},
+		{"Article.start", "<h4>%s</h4>", "<h4>{{.Name}}</h4>"},
+		{"Article.Main.Profile.Detail.icon1", "<img src=\"%s\">", "<img src=\"{{.Icon}}\">"},
+		{"Article.Main.Profile.Detail.personal.status", "%s%s%s%s", "{{.Attribute}}{{.Type}}{{.Hp}}{{.Attack}}"},
+		{"Article.Main.Profile.Detail.personal.profile", "<p>%v</p>", "<p>{{.Profile}}</p>"},
+		{"Article.Main.Profile.Ability.content", "%s%s%s%s", "{{.Ability.Name}}{{.Ability.Effect}}{{.Ability.Interval}}{{.Ability.EffectTime}}"},
+		{"Article.Main.Profile.Episode.content", "%s%s%s%s", "{{.Episode.Title}}{{.Episode.Tag}}{{.Episode.Contents}}{{.Episode.Outline}}"},
+		{"threshold.higher", "<span>%s</span>", "<span>{{.Value}}</span>"},
+	}
+
+	covered := map[string]bool{}
+
+	for _, tt := range tests {
+		covered[tt.name] = true
+
+		got, err := Snippet{Name: tt.name, Text: tt.text}.convert(SyntaxAuto)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	for name := range positional {
+		if !covered[name] {
+			t.Errorf("%s: not covered", name)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		text    string
+		syntax  string
+		want    string
+		err     string
+	}{
+		{name: "percent", text: "<h3>%s 100%%</h3>", want: "<h3>{{.Headline}} 100%</h3>"},
+		{name: "percent only", text: "<h3>100%%</h3>", want: "<h3>100%</h3>"},
+		{name: "too few", snippet: "Toc.item", text: "<a href=\"%s\">", want: "<a href=\"{{.Anchor}}\">"},
+		{name: "too many", text: "<h3>%s %s</h3>", err: "too many placeholders, at most 1 allowed"},
+		{name: "incomplete", text: "<h3>%s %", err: "incomplete placeholder"},
+		{name: "width", text: "<h3>%-5s</h3>", want: "<h3>{{printf \"%-5s\" .Headline}}</h3>"},
+		{name: "braces", text: "<h3>{{ %s</h3>", syntax: SyntaxPrintf, want: "<h3>{{\"{{\"}} {{.Headline}}</h3>"},
+		{name: "template", text: "<h3>{{.Headline}} %s</h3>", want: "<h3>{{.Headline}} %s</h3>"},
+		{name: "literal", text: "<h3>{{.Headline}}</h3>", syntax: SyntaxPrintf, want: "<h3>{{\"{{\"}}.Headline}}</h3>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := tt.snippet
+			if len(name) == 0 {
+				name = "headline"
+			}
+
+			got, err := Snippet{Name: name, Text: tt.text}.convert(tt.syntax)
+			if len(tt.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got %q, %v, want error %q", got, err, tt.err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"sort"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func init() {
+	validation.ErrorTag = "toml"
+}
+
+type Problem struct {
+	Path    string
+	Message string
+	Origin  Origin
+}
+
+func (p Problem) Error() string {
+	if len(p.Origin.Source) == 0 {
+		return fmt.Sprintf("%s: %s", p.Path, p.Message)
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", p.Path, p.Message, p.Origin)
+}
+
+type Problems []Problem
+
+func (p Problems) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("invalid configuration, %d problem(s) found:", len(p)))
+
+	for _, problem := range p {
+		sb.WriteString("\n  ")
+		sb.WriteString(problem.Error())
+	}
+
+	return sb.String()
+}
+
+func problems(err error, found origins) Problems {
+	var result Problems
+
+	flatten("", err, func(path string, message string) {
+		result = append(result, Problem{Path: path, Message: message, Origin: found.lookup(path)})
+	})
+
+	return result
+}
+
+func (p Problems) covers(path string) bool {
+	for _, problem := range p {
+		if strings.EqualFold(problem.Path, path) || strings.HasPrefix(strings.ToLower(path), strings.ToLower(problem.Path)+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Problems) within(path string) bool {
+	for _, problem := range p {
+		if strings.EqualFold(problem.Path, path) || strings.HasPrefix(strings.ToLower(problem.Path), strings.ToLower(path)+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Problems) sort() {
+	sort.SliceStable(p, func(i, j int) bool {
+		return p[i].Path < p[j].Path
+	})
+}
+
+func flatten(path string, err error, fn func(path string, message string)) {
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		fn(path, err.Error())
+		return
+	}
+
+	for k, v := range errs {
+		if v == nil {
+			continue
+		}
+
+		if _, isIndex := strconv.Atoi(k); isIndex == nil {
+			flatten(path+"["+k+"]", v, fn)
+		} else if len(path) == 0 {
+			flatten(k, v, fn)
+		} else {
+			flatten(path+"."+k, v, fn)
+		}
+	}
+}
+
+func (a Application) Validate() error {
+	return validation.ValidateStruct(&a,
+		validation.Field(&a.Excel),
+		validation.Field(&a.Html),
+	)
+}
+
+func column(columns []string) []validation.Rule {
+	rules := []validation.Rule{validation.Required}
+
+	if len(columns) > 0 {
+		values := make([]interface{}, len(columns))
+		for i, v := range columns {
+			values[i] = v
+		}
+
+		rules = append(rules, validation.In(values...).Error("must be a column of the sheet"))
+	}
+
+	return rules
+}
+
+func (e Excel) Validate() error {
+	column := column(e.Headers())
+
+	return validation.ValidateStruct(&e,
+		validation.Field(&e.Dataset, validation.Required),
+		validation.Field(&e.Key, validation.Required, validation.Each(column...)),
+		validation.Field(&e.Sort, validation.Each(validation.By(func(value interface{}) error {
+			sort := value.(Sort)
+			return validation.ValidateStruct(&sort,
+				validation.Field(&sort.Name, column...),
+			)
+		}))),
+		validation.Field(&e.Optional, validation.Each(validation.Required, validation.In(e.flags()...).Error("must be a key of Excel.Columns"))),
+		validation.Field(&e.Skip),
+		validation.Field(&e.Columns, validation.Required, validation.By(func(interface{}) error {
+			errs := validation.Errors{}
+
+			for field, aliases := range e.Columns {
+				err := validation.Validate(field, validation.Required)
+				if err == nil {
+					err = validation.Validate(aliases, validation.Required, validation.Each(validation.Required))
+				}
+				if err != nil {
+					errs[field] = err
+				}
+			}
+
+			for _, field := range e.fields {
+				if _, ok := e.Columns[field]; !ok {
+					errs[field] = errors.New("must be defined for the field of the record")
+				}
+			}
+
+			return errs.Filter()
+		})),
+		validation.Field(&e.Families),
+		validation.Field(&e.Forms, validation.Required, validation.By(func(interface{}) error {
+			errs := validation.Errors{}
+			names := map[string]bool{}
+
+			for i, form := range e.Forms {
+				err := validation.ValidateStruct(&form,
+					validation.Field(&form.Name, validation.Required, validation.By(func(interface{}) error {
+						if names[form.Name] {
+							return errors.New("must be unique")
+						}
+
+						return nil
+					})),
+					validation.Field(&form.Flag, validation.In(e.flags()...).Error("must be a key of Excel.Columns")),
+					validation.Field(&form.Episodes, validation.Min(0)),
+					validation.Field(&form.Abilities, validation.Min(0)),
+				)
+				if err != nil {
+					errs[strconv.Itoa(i)] = err
+				}
+
+				names[form.Name] = true
+			}
+
+			return errs.Filter()
+		})),
+	)
+}
+
+func (e Excel) flags() []interface{} {
+	flags := make([]interface{}, 0, len(e.Columns))
+	for _, field := range e.Fields() {
+		flags = append(flags, field)
+	}
+
+	return flags
+}
+
+func (f Families) Validate() error {
+	family := []validation.Rule{validation.Required, validation.Each(validation.Required, validation.By(func(value interface{}) error {
+		if strings.Count(value.(string), "%d") != 1 || strings.Count(value.(string), "%") != 1 {
+			return errors.New("must contain exactly one %d placeholder")
+		}
+
+		return nil
+	}))}
+
+	return validation.ValidateStruct(&f,
+		validation.Field(&f.Status, validation.By(func(interface{}) error {
+			return validation.ValidateStruct(&f.Status,
+				validation.Field(&f.Status.Hp, family...),
+				validation.Field(&f.Status.Attack, family...),
+				validation.Field(&f.Status.Profile, family...),
+			)
+		})),
+		validation.Field(&f.Episode, validation.By(func(interface{}) error {
+			return validation.ValidateStruct(&f.Episode,
+				validation.Field(&f.Episode.Title, family...),
+				validation.Field(&f.Episode.Outline, family...),
+				validation.Field(&f.Episode.Contents, family...),
+				validation.Field(&f.Episode.Tag, family...),
+			)
+		})),
+		validation.Field(&f.Ability, validation.By(func(interface{}) error {
+			return validation.ValidateStruct(&f.Ability,
+				validation.Field(&f.Ability.Name, family...),
+				validation.Field(&f.Ability.Effect, family...),
+				validation.Field(&f.Ability.Interval, family...),
+				validation.Field(&f.Ability.EffectTime, family...),
+			)
+		})),
+	)
+}
+
+func (d Dataset) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sheet, validation.Required),
+		validation.Field(&d.Rarity, validation.Required, validation.By(func(interface{}) error {
+			if len(d.rarities) == 0 {
+				return nil
+			}
+
+			for _, v := range d.rarities {
+				if strings.EqualFold(v, d.Rarity) {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("must be one of %s (keys of Html.Threshold)", strings.Join(d.rarities, ", "))
+		})),
+		validation.Field(&d.Icon, validation.Required, placeholders(1)),
+		validation.Field(&d.Output, validation.Required),
+		validation.Field(&d.Collection, validation.In(CollectionAll, CollectionOwned, CollectionMissing, CollectionMark).Error(
+			fmt.Sprintf("must be one of %s, %s, %s or %s", CollectionAll, CollectionOwned, CollectionMissing, CollectionMark),
+		)),
+	)
+}
+
+func (s Skip) Validate() error {
+	return validation.ValidateStruct(&s,
+		validation.Field(&s.Row, validation.Min(0)),
+	)
+}
+
+func (h Html) Validate() error {
+	errs := validation.Errors{}
+
+	err := validation.ValidateStruct(&h,
+		validation.Field(&h.Headlines, validation.Each(validation.Required, validation.In(syllabary()...).Error(
+			fmt.Sprintf("must be one of %s", strings.Join(Syllabary, ", ")),
+		)), validation.By(func(interface{}) error {
+			for i := 1; i < len(h.Headlines); i++ {
+				if indexOf(Syllabary, h.Headlines[i-1]) >= indexOf(Syllabary, h.Headlines[i]) {
+					return errors.New("must be in the order of the syllabary without duplicates")
+				}
+			}
+
+			return nil
+		})),
+		validation.Field(&h.Exports, validation.Each(validation.In(ExportJson, ExportMarkdown).Error(
+			fmt.Sprintf("must be one of %s or %s", ExportJson, ExportMarkdown),
+		))),
+		validation.Field(&h.Raw, validation.Each(column(h.columns)...)),
+		validation.Field(&h.Threshold, validation.Required),
+		validation.Field(&h.Pagination),
+		validation.Field(&h.Format),
+	)
+	if err != nil {
+		fields, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+
+		for k, v := range fields {
+			errs[k] = v
+		}
+	}
+
+	for _, form := range h.forms {
+		if _, ok := h.Format.Article.Main.Ribbon[form]; !ok {
+			errs["Format.Article.Main.Ribbon."+form] = errors.New("must be defined for the form")
+		}
+	}
+
+	h.tiers(errs)
+
+	return errs.Filter()
+}
+
+func (t Threshold) Validate() error {
+	return validation.ValidateStruct(&t,
+		validation.Field(&t.Hp),
+		validation.Field(&t.Attack),
+	)
+}
+
+func (p Pagination) Validate() error {
+	var size, name []validation.Rule
+
+	if p.Mode == PaginationCount {
+		size = append(size, validation.Required, validation.Min(1))
+	}
+
+	if p.Mode == PaginationCount || p.Mode == PaginationHeadline {
+		name = append(name, validation.Required, placeholders(2))
+	}
+
+	return validation.ValidateStruct(&p,
+		validation.Field(&p.Mode, validation.In(PaginationNone, PaginationCount, PaginationHeadline).Error(
+			fmt.Sprintf("must be one of %s, %s or %s", PaginationNone, PaginationCount, PaginationHeadline),
+		)),
+		validation.Field(&p.Size, size...),
+		validation.Field(&p.Name, name...),
+	)
+}
+
+func (t Tiers) Validate() error {
+	errs := validation.Errors{}
+
+	for i := 1; i < len(t); i++ {
+		if t[i].Min >= t[i-1].Min {
+			errs[strconv.Itoa(i)] = validation.Errors{
+				"min": fmt.Errorf("must be less than min of the previous tier (%d)", t[i-1].Min),
+			}
+		}
+	}
+
+	return errs.Filter()
+}
+
+func (h Html) tiers(errs validation.Errors) {
+	for rarity, threshold := range h.Threshold {
+		for stat, tiers := range map[string]Tiers{"hp": threshold.Hp, "attack": threshold.Attack} {
+			for i, tier := range tiers {
+				if _, ok := h.Format.Threshold[tier.Format]; len(tier.Format) > 0 && !ok {
+					errs[fmt.Sprintf("Threshold.%s.%s[%d].format", rarity, stat, i)] = errors.New("must be a key of Html.Format.threshold")
+				}
+			}
+		}
+	}
+}
+
+func (f Format) Validate() error {
+	errs := validation.Errors{}
+
+	err := validation.Validate(f.Syntax, validation.In(SyntaxAuto, SyntaxTemplate, SyntaxPrintf).Error(
+		fmt.Sprintf("must be one of %s, %s or %s", SyntaxAuto, SyntaxTemplate, SyntaxPrintf),
+	))
+	if err != nil {
+		errs["syntax"] = err
+	}
+
+	templates := template.New("Html.Format")
+
+	for _, snippet := range f.Snippets() {
+		_, err := snippet.parse(templates, f.Syntax)
+		if err != nil {
+			errs[snippet.Name] = err
+		}
+	}
+
+	return errs.Filter()
+}
+
+func syllabary() []interface{} {
+	values := make([]interface{}, len(Syllabary))
+	for i, v := range Syllabary {
+		values[i] = v
+	}
+
+	return values
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func placeholders(expected int) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		format, _ := value.(string)
+
+		count := 0
+		for i := 0; i < len(format); i++ {
+			if format[i] != '%' {
+				continue
+			}
+
+			i++
+			if i < len(format) && format[i] != '%' {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("must contain %d placeholder(s), found %d", expected, count)
+		}
+
+		return nil
+	})
+}
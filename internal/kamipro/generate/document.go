@@ -0,0 +1,76 @@
+package generate
+
+import (
+	"html/template"
+	"os"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+type Document struct {
+	Title         string
+	Lang          string
+	Stylesheets   []string
+	Scripts       []string
+	Styles        []template.CSS
+	InlineScripts []template.JS
+}
+
+func NewDocument(html *application.Html, title string) (*Document, error) {
+	if !html.Document.Standalone {
+		return nil, nil
+	}
+
+	document := Document{
+		Title:       title,
+		Lang:        html.Document.Lang,
+		Stylesheets: html.Document.Stylesheets,
+		Scripts:     html.Document.Scripts,
+	}
+
+	if html.Document.DefaultStyle {
+		text, err := application.Stylesheet()
+		if err != nil {
+			return nil, err
+		}
+
+		document.Styles = append(document.Styles, template.CSS(text))
+	}
+
+	for _, path := range html.Document.Styles {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		document.Styles = append(document.Styles, template.CSS(text))
+	}
+
+	for _, path := range html.Document.InlineScripts {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		document.InlineScripts = append(document.InlineScripts, template.JS(text))
+	}
+
+	return &document, nil
+}
+
+func (d *Document) start(templates *template.Template, sb *strings.Builder) error {
+	if d == nil {
+		return nil
+	}
+
+	return templates.ExecuteTemplate(sb, "Document.start", d)
+}
+
+func (d *Document) close(templates *template.Template, sb *strings.Builder) error {
+	if d == nil {
+		return nil
+	}
+
+	return templates.ExecuteTemplate(sb, "Document.close", d)
+}
@@ -0,0 +1,178 @@
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+type Export struct {
+	Sheet     string           `json:"sheet"`
+	Rarity    string           `json:"rarity"`
+	Title     string           `json:"title"`
+	Count     int              `json:"count"`
+	Headlines []ExportHeadline `json:"headlines"`
+}
+
+type ExportHeadline struct {
+	Headline   string      `json:"headline"`
+	Anchor     string      `json:"anchor"`
+	Characters []Character `json:"characters"`
+}
+
+type Character struct {
+	No        int         `json:"no"`
+	Name      string      `json:"name"`
+	Furigana  string      `json:"furigana"`
+	Attribute string      `json:"attribute"`
+	Type      string      `json:"type"`
+	Owned     bool        `json:"owned"`
+	Sets      []ExportSet `json:"sets"`
+}
+
+type ExportSet struct {
+	ArticleSet
+	Hp         *int   `json:"hp"`
+	Attack     *int   `json:"attack"`
+	HpTier     string `json:"hp_tier"`
+	AttackTier string `json:"attack_tier"`
+}
+
+func export(
+	setting *Setting,
+	excel *application.Excel,
+	html *application.Html,
+	format string,
+	groups []Group,
+	hp Threshold,
+	attack Threshold,
+) error {
+	switch format {
+	case application.ExportJson:
+		text, err := exportJson(setting, excel, html, groups, hp, attack)
+		if err != nil {
+			return err
+		}
+
+		return setting.Sink.Write(extension(setting.Output, ".json"), text)
+	case application.ExportMarkdown:
+		text, err := exportMarkdown(setting, excel, html, groups)
+		if err != nil {
+			return err
+		}
+
+		return setting.Sink.Write(extension(setting.Output, ".md"), text)
+	}
+
+	return nil
+}
+
+func exportJson(
+	setting *Setting,
+	excel *application.Excel,
+	html *application.Html,
+	groups []Group,
+	hp Threshold,
+	attack Threshold,
+) (string, error) {
+	data := Export{
+		Sheet:     setting.Sheet,
+		Rarity:    setting.Rarity,
+		Title:     setting.Title,
+		Headlines: []ExportHeadline{},
+	}
+
+	for _, group := range groups {
+		if len(group.Records) == 0 {
+			continue
+		}
+
+		headline := ExportHeadline{Headline: group.Headline, Anchor: group.Anchor}
+
+		for _, record := range group.Records {
+			character := Character{
+				No:        record.No,
+				Name:      record.Name,
+				Furigana:  record.Furigana,
+				Attribute: record.Attribute,
+				Type:      record.Type,
+				Owned:     record.IsGet(),
+				Sets:      []ExportSet{},
+			}
+
+			for _, set := range record.Sets(setting, excel.Forms, &html.Icon) {
+				if set.Abilities == nil {
+					set.Abilities = []Ability{}
+				}
+
+				character.Sets = append(character.Sets, ExportSet{
+					ArticleSet: set,
+					Hp:         number(set.Hp),
+					Attack:     number(set.Attack),
+					HpTier:     classify(hp, set.Hp),
+					AttackTier: classify(attack, set.Attack),
+				})
+			}
+
+			headline.Characters = append(headline.Characters, character)
+		}
+
+		data.Count += len(headline.Characters)
+		data.Headlines = append(data.Headlines, headline)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "\t")
+
+	err := encoder.Encode(&data)
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+func classify(threshold Threshold, value interface{}) string {
+	parameter := number(value)
+	if parameter == nil {
+		return ""
+	}
+
+	tier, _ := threshold.Classify(*parameter)
+
+	return tier.Name
+}
+
+func number(value interface{}) *int {
+	switch v := value.(type) {
+	case int:
+		return &v
+	case float64:
+		if v != float64(int(v)) {
+			return nil
+		}
+
+		n := int(v)
+		return &n
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil
+		}
+
+		return &n
+	}
+
+	return nil
+}
+
+func extension(output string, extension string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + extension
+}
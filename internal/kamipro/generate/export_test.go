@@ -0,0 +1,61 @@
+package generate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func TestExportJsonNumbers(t *testing.T) {
+	record := &Record{
+		No:            1,
+		EpisodeNumber: 1,
+		Statuses:      []Status{{Number: 1, Hp: "1700", Attack: "-"}},
+		Episodes:      []Episode{{Number: 1, Title: "一"}},
+	}
+	forms := []application.Form{{Name: "Normal", Episodes: 1}, {Name: "Awaking", Episodes: 1}}
+
+	tests := []struct {
+		hp     interface{}
+		attack interface{}
+		want   []interface{}
+	}{
+		{"1700", "-", []interface{}{1700.0, nil}},
+		{1500, nil, []interface{}{1500.0, nil}},
+		{" 900 ", "", []interface{}{900.0, nil}},
+		{1200.0, 7000.5, []interface{}{1200.0, nil}},
+	}
+
+	for _, tt := range tests {
+		record.Statuses[0].Hp, record.Statuses[0].Attack = tt.hp, tt.attack
+
+		text, err := exportJson(&Setting{Icon: "%03d"}, &application.Excel{Forms: forms}, &application.Html{}, []Group{{Records: []*Record{record}}}, Threshold{}, Threshold{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var data struct {
+			Headlines []struct {
+				Characters []struct {
+					Sets []map[string]interface{} `json:"sets"`
+				} `json:"characters"`
+			} `json:"headlines"`
+		}
+
+		err = json.Unmarshal([]byte(text), &data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sets := data.Headlines[0].Characters[0].Sets
+		if got := []interface{}{sets[0]["hp"], sets[0]["attack"]}; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%#v, %#v: got %v, want %v", tt.hp, tt.attack, got, tt.want)
+		}
+
+		if hp, ok := sets[1]["hp"]; !ok || hp != nil {
+			t.Errorf("missing status: got %#v, %t, want null", hp, ok)
+		}
+	}
+}
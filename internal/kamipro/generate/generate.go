@@ -2,7 +2,7 @@ package generate
 
 import (
 	"fmt"
-	"os"
+	"html/template"
 	"reflect"
 	"strconv"
 	"strings"
@@ -16,304 +16,530 @@ import (
 )
 
 type Setting struct {
-	Rarity, Icon, Output string
+	Sheet, Rarity, Icon, Output, Collection, Title string
+	Sink                                           Sink
+}
+
+type Result struct {
+	Sheet    string
+	Rarity   string
+	Output   string
+	Count    int
+	Warnings []string
 }
 
 type Record struct {
-	No               int         `mapstructure:"No"`
-	Name             string      `mapstructure:"神姫名"`
-	Furigana         string      `mapstructure:"神姫名 (ひらがな)"`
-	Attribute        string      `mapstructure:"属性"`
-	Type             string      `mapstructure:"タイプ"`
-	HP1              interface{} `mapstructure:"HP1"`
-	Attack1          interface{} `mapstructure:"Attack1"`
-	HP2              interface{} `mapstructure:"HP2"`
-	Attack2          interface{} `mapstructure:"Attack2"`
-	HP3              interface{} `mapstructure:"HP3"`
-	Attack3          interface{} `mapstructure:"Attack3"`
-	EpisodeNumber    float64     `mapstructure:"エピソ－ド数"`
-	Awaking          string      `mapstructure:"神化覚醒"`
-	Otherwise        string      `mapstructure:"神想真化"`
-	Profile1         string      `mapstructure:"プロフィ－ル1"`
-	Profile2         string      `mapstructure:"プロフィ－ル2"`
-	Profile3         string      `mapstructure:"プロフィ－ル3"`
-	Ability1         string      `mapstructure:"アビリティ1"`
-	Effect1          string      `mapstructure:"効果1"`
-	Interval1        string      `mapstructure:"使用間隔1"`
-	EffectTime1      string      `mapstructure:"効果時間1"`
-	Ability2         string      `mapstructure:"アビリティ2"`
-	Effect2          string      `mapstructure:"効果2"`
-	Interval2        string      `mapstructure:"使用間隔2"`
-	EffectTime2      string      `mapstructure:"効果時間2"`
-	Ability3         string      `mapstructure:"アビリティ3"`
-	Effect3          string      `mapstructure:"効果3"`
-	Interval3        string      `mapstructure:"使用間隔3"`
-	EffectTime3      string      `mapstructure:"効果時間3"`
-	Ability4         string      `mapstructure:"アビリティ4"`
-	Effect4          string      `mapstructure:"効果4"`
-	Interval4        string      `mapstructure:"使用間隔4"`
-	EffectTime4      string      `mapstructure:"効果時間4"`
-	Episode1         string      `mapstructure:"エピソ－ド1"`
-	Outline1         string      `mapstructure:"あらすじ1"`
-	Contents1        string      `mapstructure:"内容1"`
-	Tag1             string      `mapstructure:"タグ1"`
-	Episode2         string      `mapstructure:"エピソ－ド2"`
-	Outline2         string      `mapstructure:"あらすじ2"`
-	Contents2        string      `mapstructure:"内容2"`
-	Tag2             string      `mapstructure:"タグ2"`
-	Episode3         string      `mapstructure:"エピソ－ド3"`
-	Outline3         string      `mapstructure:"あらすじ3"`
-	Contents3        string      `mapstructure:"内容3"`
-	Tag3             string      `mapstructure:"タグ3"`
-	Episode4         string      `mapstructure:"エピソ－ド4"`
-	Outline4         string      `mapstructure:"あらすじ4"`
-	Contents4        string      `mapstructure:"内容4"`
-	Tag4             string      `mapstructure:"タグ4"`
-	Html1            string      `mapstructure:"HTML1"`
-	HtmlDestination1 string      `mapstructure:"HTML設定先1"`
-	Html2            string      `mapstructure:"HTML2"`
-	HtmlDestination2 string      `mapstructure:"HTML設定先2"`
-	GetFlag          string      `mapstructure:"取得フラグ"`
-}
-
-func (r Record) AttributeWithHtml(format *application.Attribute) string {
-	switch r.Attribute {
-	case "火":
-		return format.Fire
-	case "水":
-		return format.Water
-	case "風":
-		return format.Wind
-	case "雷":
-		return format.Thunder
-	case "光":
-		return format.Light
-	case "闇":
-		return format.Darkness
-	default:
-		return r.Attribute
-	}
-}
-
-func (r Record) TypeWithHtml(format *application.Type) string {
-	switch r.Type {
-	case "Attack":
-		return format.Attack
-	case "Defense":
-		return format.Defense
-	case "Tricky":
-		return format.Tricky
-	case "Balance":
-		return format.Balance
-	case "Healer":
-		return format.Healer
-	default:
-		return r.Type
-	}
+	No               int
+	Name             string
+	Furigana         string
+	Attribute        string
+	Type             string
+	EpisodeNumber    float64
+	Html1            string
+	HtmlDestination1 string
+	Html2            string
+	HtmlDestination2 string
+	GetFlag          string
+	Statuses         []Status
+	Episodes         []Episode
+	Abilities        []Ability
+
+	values map[string]interface{}
 }
 
-func (r Record) IsAwaking() bool {
-	return r.Awaking == "TRUE"
+type Status struct {
+	Number  int
+	Hp      interface{}
+	Attack  interface{}
+	Profile string
 }
 
-func (r Record) IsOtherwise() bool {
-	return r.Otherwise == "TRUE"
+func Fields() []string {
+	t := reflect.TypeOf(Record{})
+
+	fields := make([]string, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && field.Type.Kind() != reflect.Slice {
+			fields = append(fields, field.Name)
+		}
+	}
+
+	return fields
 }
 
 func (r Record) IsGet() bool {
 	return r.GetFlag == "TRUE"
 }
 
-func (r Record) GetNormalSet(
-	setting *Setting,
-	format *application.Format,
-	icon *application.Icon,
-) ArticleSet {
-	episodes := []Episode{{
-		Title:    r.Episode1,
-		Outline:  r.Outline1,
-		Contents: r.Contents1,
-		Tag:      r.Tag1,
-	}}
-
-	if r.EpisodeNumber > 1 {
-		episodes = append(episodes, Episode{
-			Title:    r.Episode2,
-			Outline:  r.Outline2,
-			Contents: r.Contents2,
-			Tag:      r.Tag2,
-		})
+func (r Record) IsCollected(collection string) bool {
+	switch collection {
+	case application.CollectionOwned:
+		return r.IsGet()
+	case application.CollectionMissing:
+		return !r.IsGet()
+	default:
+		return true
 	}
+}
+
+func (r Record) Flag(field string) bool {
+	return text(r.values[field]) == "TRUE"
+}
 
-	return ArticleSet{
-		Type:     Normal,
-		Hp:       r.HP1,
-		Attack:   r.Attack1,
-		Profile:  r.Profile1,
-		Icon:     fmt.Sprintf(format.Article.Main.Profile.Detail.Icon1, icon.BaseUrl+fmt.Sprintf(setting.Icon, r.No)+icon.Extension),
-		Episodes: episodes,
+func (r Record) IconUrl(setting *Setting, icon *application.Icon, suffix string) string {
+	base := icon.BaseUrl
+	if len(base) > 0 && !strings.HasSuffix(base, "/") {
+		base += "/"
 	}
+
+	return base + fmt.Sprintf(setting.Icon, r.No) + suffix + icon.Extension
 }
 
-func (r Record) GetAwakingSet(
-	setting *Setting,
-	format *application.Format,
-	icon *application.Icon,
-) *ArticleSet {
-	if r.EpisodeNumber < 3 || !r.IsAwaking() {
-		return nil
-	} else {
-		temporary := format.Article.Main.Profile.Detail.Icon2
+func (r Record) Status(number int) Status {
+	if number <= len(r.Statuses) {
+		return r.Statuses[number-1]
+	}
+
+	return Status{Number: number}
+}
 
-		if r.Episode3 != icon.NoDataDecisionCharacter {
-			temporary = fmt.Sprintf(format.Article.Main.Profile.Detail.Icon1, icon.BaseUrl+fmt.Sprintf(setting.Icon, r.No)+icon.Awaking+icon.Extension)
+func (r Record) Episode(number int) Episode {
+	if number <= len(r.Episodes) {
+		return r.Episodes[number-1]
+	}
+
+	return Episode{Number: number}
+}
+
+func (r Record) Sets(setting *Setting, forms []application.Form, icon *application.Icon) []ArticleSet {
+	var sets []ArticleSet
+
+	number := int(r.EpisodeNumber)
+	first, ability := 1, 1
+
+	for _, form := range forms {
+		if len(form.Flag) > 0 && (!r.Flag(form.Flag) || first > number) {
+			continue
 		}
 
-		return &ArticleSet{
-			Type:    Awaking,
-			Hp:      r.HP2,
-			Attack:  r.Attack2,
-			Profile: r.Profile2,
-			Icon:    temporary,
-			Episodes: []Episode{{
-				Title:    r.Episode3,
-				Outline:  r.Outline3,
-				Contents: r.Contents3,
-				Tag:      r.Tag3,
-			}},
+		last := number
+		if form.Episodes > 0 && first+form.Episodes-1 < last {
+			last = first + form.Episodes - 1
+		}
+		if last < first {
+			last = first
+		}
+
+		status := r.Status(len(sets) + 1)
+
+		set := ArticleSet{
+			Form:    form.Name,
+			Slot:    status.Number,
+			Hp:      status.Hp,
+			Attack:  status.Attack,
+			Profile: status.Profile,
+			Icon:    r.IconUrl(setting, icon, form.Icon),
+		}
+
+		for n := first; n <= last; n++ {
+			set.Episodes = append(set.Episodes, r.Episode(n))
+		}
+
+		set.Abilities, ability = r.take(ability, form.Abilities)
+
+		if form.NoData && set.Episodes[0].Title == icon.NoDataDecisionCharacter {
+			set.Icon = ""
+		}
+
+		sets = append(sets, set)
+
+		if form.Episodes > 0 {
+			first += form.Episodes
+		} else {
+			first = last + 1
+		}
+	}
+
+	if len(sets) > 0 {
+		for n := first; n <= number; n++ {
+			sets[len(sets)-1].Episodes = append(sets[len(sets)-1].Episodes, r.Episode(n))
 		}
 	}
+
+	return sets
 }
 
-func (r Record) GetOtherwiseSet(
-	setting *Setting,
-	format *application.Format,
-	icon *application.Icon,
-) *ArticleSet {
-	temporary := format.Article.Main.Profile.Detail.Icon2
+func (r Record) Unassigned(sets []ArticleSet) []string {
+	assigned := map[int]bool{}
 
-	if r.EpisodeNumber < 3 || !r.IsOtherwise() {
-		return nil
-	} else if r.IsAwaking() {
-		if r.Episode4 != icon.NoDataDecisionCharacter {
-			temporary = fmt.Sprintf(format.Article.Main.Profile.Detail.Icon1, icon.BaseUrl+fmt.Sprintf(setting.Icon, r.No)+icon.Otherwise+icon.Extension)
-		}
-
-		return &ArticleSet{
-			Type:    Otherwise,
-			Hp:      r.HP3,
-			Attack:  r.Attack3,
-			Profile: r.Profile3,
-			Icon:    temporary,
-			Episodes: []Episode{{
-				Title:    r.Episode4,
-				Outline:  r.Outline4,
-				Contents: r.Contents4,
-				Tag:      r.Tag4,
-			}},
+	for _, set := range sets {
+		for _, episode := range set.Episodes {
+			assigned[episode.Number] = true
 		}
-	} else {
-		if r.Episode3 != icon.NoDataDecisionCharacter {
-			temporary = fmt.Sprintf(format.Article.Main.Profile.Detail.Icon1, icon.BaseUrl+fmt.Sprintf(setting.Icon, r.No)+icon.Otherwise+icon.Extension)
+	}
+
+	var numbers []string
+
+	for _, episode := range r.Episodes {
+		if !assigned[episode.Number] && len(episode.Title) > 0 {
+			numbers = append(numbers, strconv.Itoa(episode.Number))
+		}
+	}
+
+	return numbers
+}
+
+func (r Record) take(first int, count int) ([]Ability, int) {
+	var abilities []Ability
+
+	last := len(r.Abilities)
+	if count > 0 && first+count-1 < last {
+		last = first + count - 1
+	}
+
+	for n := first; n <= last; n++ {
+		if len(r.Abilities[n-1].Name) > 0 {
+			abilities = append(abilities, r.Abilities[n-1])
+		}
+	}
+
+	if count > 0 {
+		return abilities, first + count
+	}
+
+	return abilities, last + 1
+}
+
+type Codes struct {
+	Attribute *Code
+	Type      *Code
+}
+
+func NewCodes(html *application.Html) Codes {
+	return Codes{
+		Attribute: NewCode("Attribute", html.Format.Attribute),
+		Type:      NewCode("Type", html.Format.Type),
+	}
+}
+
+func (c Codes) Warnings(setting *Setting) []string {
+	var warnings []string
+
+	for _, code := range []*Code{c.Attribute, c.Type} {
+		if len(code.unmapped) == 0 {
+			continue
 		}
 
-		return &ArticleSet{
-			Type:    Otherwise,
-			Hp:      r.HP2,
-			Attack:  r.Attack2,
-			Profile: r.Profile2,
-			Icon:    temporary,
-			Episodes: []Episode{{
-				Title:    r.Episode3,
-				Outline:  r.Outline3,
-				Contents: r.Contents3,
-				Tag:      r.Tag3,
-			}},
+		values := make([]string, len(code.unmapped))
+		for i, v := range code.unmapped {
+			values[i] = strconv.Quote(v)
 		}
+
+		warnings = append(warnings, fmt.Sprintf("%s: no Html.Format.%s snippet for %s", setting.Sheet, code.name, strings.Join(values, ", ")))
 	}
+
+	return warnings
 }
 
-type Threshold struct {
-	High Value
-	Low  Value
+type Code struct {
+	name     string
+	snippets map[string]string
+	unmapped []string
 }
 
-type Value struct {
-	Parameter int
-	Format    string
+func NewCode(name string, snippets map[string]string) *Code {
+	return &Code{name: name, snippets: snippets}
 }
 
-func (t Threshold) Html(value string) string {
+func (c *Code) Html(templates *template.Template, value string, fallback interface{}) (interface{}, error) {
+	if _, ok := c.snippets[value]; ok {
+		return render(templates, c.name+"."+value, nil)
+	}
+
+	if len(value) > 0 && !contains(c.unmapped, value) {
+		c.unmapped = append(c.unmapped, value)
+	}
+
+	return fallback, nil
+}
+
+type Threshold []Tier
+
+type Tier struct {
+	Min      int
+	Name     string
+	Template string
+}
+
+func (t Threshold) Html(templates *template.Template, value string, fallback interface{}) (interface{}, error) {
 	err := validation.Validate(value, is.Digit)
 	if err != nil {
-		return value
+		return fallback, nil
 	}
 
 	parameter, err := strconv.Atoi(value)
 	if err != nil {
-		return value
+		return fallback, nil
 	}
 
 	valueWithZeroPadding := fmt.Sprintf("%04d", parameter)
 
-	if parameter >= t.High.Parameter {
-		return fmt.Sprintf(t.High.Format, valueWithZeroPadding)
-	} else if parameter <= t.Low.Parameter {
-		return fmt.Sprintf(t.Low.Format, valueWithZeroPadding)
-	} else {
-		return valueWithZeroPadding
+	if tier, ok := t.Classify(parameter); ok && len(tier.Template) > 0 {
+		return render(templates, tier.Template, &View{Value: valueWithZeroPadding, Tier: tier.Name})
 	}
+
+	return valueWithZeroPadding, nil
 }
 
-type Type int
+func (t Threshold) Classify(parameter int) (Tier, bool) {
+	for _, tier := range t {
+		if parameter >= tier.Min {
+			return tier, true
+		}
+	}
 
-const (
-	_ Type = iota
-	Normal
-	Awaking
-	Otherwise
-)
+	return Tier{}, false
+}
 
 type ArticleSet struct {
-	Type     Type
-	Hp       interface{}
-	Attack   interface{}
-	Profile  string
-	Icon     string
-	Episodes []Episode
+	Form      string      `json:"form"`
+	Slot      int         `json:"slot"`
+	Hp        interface{} `json:"hp"`
+	Attack    interface{} `json:"attack"`
+	Profile   string      `json:"profile"`
+	Icon      string      `json:"icon"`
+	Episodes  []Episode   `json:"episodes"`
+	Abilities []Ability   `json:"abilities"`
 }
 
 type Episode struct {
-	Title    string
-	Outline  string
-	Contents string
-	Tag      string
+	Number   int    `json:"number"`
+	Title    string `json:"title"`
+	Outline  string `json:"outline"`
+	Contents string `json:"contents"`
+	Tag      string `json:"tag"`
+}
+
+type Ability struct {
+	Number     int    `json:"number"`
+	Name       string `json:"name"`
+	Effect     string `json:"effect"`
+	Interval   string `json:"interval"`
+	EffectTime string `json:"effect_time"`
+}
+
+type View struct {
+	Headline  string
+	Name      interface{}
+	Attribute interface{}
+	Type      interface{}
+	Hp        interface{}
+	Attack    interface{}
+	Profile   interface{}
+	Icon      string
+	Episode   EpisodeView
+	Ability   AbilityView
+	Value     string
+	Tier      string
+	Anchor    string
+	Count     int
+	Sheet     string
+	Rarity    string
+	Link      string
+	Page      int
+	Pages     int
+	Class     string
+	Badge     interface{}
+}
+
+type EpisodeView struct {
+	Title    interface{}
+	Outline  interface{}
+	Contents interface{}
+	Tag      interface{}
+}
+
+type AbilityView struct {
+	Name       interface{}
+	Effect     interface{}
+	Interval   interface{}
+	EffectTime interface{}
+}
+
+type Cells struct {
+	excel *application.Excel
+	raw   map[string]bool
+}
+
+func NewCells(excel *application.Excel, raw []string) Cells {
+	cells := Cells{excel: excel, raw: map[string]bool{}}
+
+	for _, column := range raw {
+		if header, ok := excel.Primary(column); ok {
+			cells.raw[header] = true
+		}
+	}
+
+	return cells
+}
+
+func (c Cells) Header(header string, value string) interface{} {
+	if c.raw[header] {
+		return template.HTML(value)
+	}
+
+	return value
+}
+
+func (c Cells) Field(field string, value string) (interface{}, error) {
+	aliases := c.excel.Columns[field]
+	if len(aliases) == 0 {
+		return nil, fmt.Errorf("no column for %s in Excel.Columns", field)
+	}
+
+	return c.Header(aliases[0], value), nil
+}
+
+func (c Cells) Member(patterns []string, number int, value string) interface{} {
+	if c.raw[patterns[0]] {
+		return template.HTML(value)
+	}
+
+	return c.Header(fmt.Sprintf(patterns[0], number), value)
+}
+
+func (c Cells) Episode(episode Episode) EpisodeView {
+	family := c.excel.Families.Episode
+
+	return EpisodeView{
+		Title:    c.Member(family.Title, episode.Number, episode.Title),
+		Outline:  c.Member(family.Outline, episode.Number, episode.Outline),
+		Contents: c.Member(family.Contents, episode.Number, episode.Contents),
+		Tag:      c.Member(family.Tag, episode.Number, episode.Tag),
+	}
+}
+
+func (c Cells) Ability(ability Ability) AbilityView {
+	family := c.excel.Families.Ability
+
+	return AbilityView{
+		Name:       c.Member(family.Name, ability.Number, ability.Name),
+		Effect:     c.Member(family.Effect, ability.Number, ability.Effect),
+		Interval:   c.Member(family.Interval, ability.Number, ability.Interval),
+		EffectTime: c.Member(family.EffectTime, ability.Number, ability.EffectTime),
+	}
+}
+
+func render(templates *template.Template, name string, view *View) (template.HTML, error) {
+	var sb strings.Builder
+
+	err := templates.ExecuteTemplate(&sb, name, view)
+	if err != nil {
+		return "", err
+	}
+
+	return template.HTML(sb.String()), nil
 }
 
 func Start(
 	setting *Setting,
-	key *[]string,
-	sort *[]application.Sort,
+	excel *application.Excel,
 	html *application.Html,
 	records *[][]string,
-) error {
-	df := setup(key, sort, records)
-	return generate(setting, html, df)
+) (*Result, error) {
+	df, warnings, err := setup(setting, excel, records)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := generate(setting, excel, html, df)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Warnings = append(warnings, result.Warnings...)
+
+	return result, nil
 }
 
-func setup(key *[]string, conditions *[]application.Sort, records *[][]string) *dataframe.DataFrame {
+func setup(setting *Setting, excel *application.Excel, records *[][]string) (*dataframe.DataFrame, []string, error) {
+	if len(*records) == 0 {
+		return nil, nil, fmt.Errorf("%s: header row not found", setting.Sheet)
+	}
+
+	warnings, err := columns(setting, excel, (*records)[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(*records) == 1 {
+		return &dataframe.DataFrame{}, warnings, nil
+	}
+
 	df := dataframe.LoadRecords(*records)
-	dropna(key, &df)
-	sort(conditions, &df)
-	return &df
+
+	dropna(excel, &df)
+	sort(excel, &df)
+
+	if df.Err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", setting.Sheet, df.Err)
+	}
+
+	return &df, warnings, nil
 }
 
-func dropna(key *[]string, df *dataframe.DataFrame) {
+func columns(setting *Setting, excel *application.Excel, header []string) ([]string, error) {
+	found := map[string]bool{}
+
+	for i, v := range header {
+		header[i], _ = excel.Primary(v)
+		found[header[i]] = true
+	}
+
+	var missing, required []string
+
+	for _, field := range excel.Fields() {
+		aliases := excel.Columns[field]
+		if found[aliases[0]] || excel.IsOptional(field) || !excel.IsUsed(field) {
+			continue
+		}
+
+		missing = append(missing, fmt.Sprintf("%s (%s)", aliases[0], field))
+	}
+
+	for _, v := range excel.Key {
+		if name, _ := excel.Primary(v); !found[name] {
+			required = append(required, name)
+		}
+	}
+
+	for _, v := range excel.Sort {
+		if name, _ := excel.Primary(v.Name); !found[name] {
+			required = append(required, name)
+		}
+	}
+
+	if len(required) > 0 {
+		return nil, fmt.Errorf("%s: missing required column(s) %s", setting.Sheet, strings.Join(required, ", "))
+	}
+
+	if len(missing) > 0 {
+		return []string{fmt.Sprintf("%s: missing column(s) %s", setting.Sheet, strings.Join(missing, ", "))}, nil
+	}
+
+	return nil, nil
+}
+
+func dropna(excel *application.Excel, df *dataframe.DataFrame) {
 	var filters []dataframe.F
 
-	for _, v := range *key {
+	for _, v := range excel.Key {
+		name, _ := excel.Primary(v)
+
 		filters = append(filters, dataframe.F{
-			Colname:    v,
+			Colname:    name,
 			Comparator: series.Neq,
 			Comparando: "",
 		})
@@ -322,275 +548,458 @@ func dropna(key *[]string, df *dataframe.DataFrame) {
 	*df = df.Filter(filters...)
 }
 
-func sort(conditions *[]application.Sort, df *dataframe.DataFrame) {
+func sort(excel *application.Excel, df *dataframe.DataFrame) {
 	var order []dataframe.Order
 
-	for _, v := range *conditions {
+	for _, v := range excel.Sort {
+		name, _ := excel.Primary(v.Name)
+
 		if v.Ascending {
-			order = append(order, dataframe.Sort(v.Name))
+			order = append(order, dataframe.Sort(name))
 		} else {
-			order = append(order, dataframe.RevSort(v.Name))
+			order = append(order, dataframe.RevSort(name))
 		}
 	}
 
 	*df = df.Arrange(order...)
 }
 
-func generate(setting *Setting, html *application.Html, df *dataframe.DataFrame) error {
-	var converted strings.Builder
-	var record Record
+func generate(setting *Setting, excel *application.Excel, html *application.Html, df *dataframe.DataFrame) (*Result, error) {
+	templates, err := html.Format.Templates()
+	if err != nil {
+		return nil, err
+	}
 
+	cells := NewCells(excel, html.Raw)
+
+	codes := NewCodes(html)
 	hp, attack := setupThreshold(setting.Rarity, html)
-	headlines := make([]string, len(html.Headlines))
-	copy(headlines, html.Headlines)
 
-	_, err := converted.WriteString(html.Format.Start)
+	groups, err := group(setting, excel, html, df)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, v := range df.Maps() {
-		err := mapstructure.Decode(v, &record)
+	document, err := NewDocument(html, setting.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := paginate(setting, &html.Pagination, groups)
+
+	for _, page := range pages {
+		var converted strings.Builder
+
+		err = document.start(templates, &converted)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		headlines, err = convert(setting, html, headlines, &hp, &attack, &record, &converted)
+		err = templates.ExecuteTemplate(&converted, "start", nil)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		if html.Toc {
+			err = toc(templates, groups, pages, page, &converted)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, group := range page.Groups {
+			if len(group.Records) > 0 && len(group.Headline) > 0 {
+				err := templates.ExecuteTemplate(&converted, "headline", &View{Headline: group.Headline, Anchor: group.Anchor})
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for _, record := range group.Records {
+				err = convert(setting, excel, html, templates, cells, codes, &hp, &attack, record, &converted)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		err = navigation(templates, pages, page, &converted)
+		if err != nil {
+			return nil, err
+		}
+
+		err = templates.ExecuteTemplate(&converted, "close", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = document.close(templates, &converted)
+		if err != nil {
+			return nil, err
+		}
+
+		err = setting.Sink.Write(page.Output, converted.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, format := range html.Exports {
+		err = export(setting, excel, html, format, groups, hp, attack)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	count := 0
+	warnings := codes.Warnings(setting)
+
+	for _, group := range groups {
+		count += len(group.Records)
+
+		for _, record := range group.Records {
+			unassigned := record.Unassigned(record.Sets(setting, excel.Forms, &html.Icon))
+			if len(unassigned) > 0 {
+				warnings = append(warnings, fmt.Sprintf("%s: episode(s) %s of %s are not assigned to any form", setting.Sheet, strings.Join(unassigned, ", "), record.Name))
+			}
+		}
+	}
+
+	return &Result{
+		Sheet:    setting.Sheet,
+		Rarity:   setting.Rarity,
+		Output:   pages[0].Output,
+		Count:    count,
+		Warnings: warnings,
+	}, nil
+}
+
+func decode(excel *application.Excel, row map[string]interface{}) (*Record, error) {
+	var record Record
+
+	values := map[string]interface{}{}
+
+	for header, v := range row {
+		if field, ok := excel.Field(header); ok {
+			values[field] = v
 		}
 	}
 
-	_, err = converted.WriteString(html.Format.Close)
+	err := mapstructure.Decode(values, &record)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	record.values = values
+
+	status := excel.Families.Status
+	for n := 1; n <= count(row, status.Hp, status.Attack, status.Profile); n++ {
+		record.Statuses = append(record.Statuses, Status{
+			Number:  n,
+			Hp:      row[fmt.Sprintf(status.Hp[0], n)],
+			Attack:  row[fmt.Sprintf(status.Attack[0], n)],
+			Profile: text(row[fmt.Sprintf(status.Profile[0], n)]),
+		})
+	}
+
+	episode := excel.Families.Episode
+	for n := 1; n <= count(row, episode.Title, episode.Outline, episode.Contents, episode.Tag); n++ {
+		record.Episodes = append(record.Episodes, Episode{
+			Number:   n,
+			Title:    text(row[fmt.Sprintf(episode.Title[0], n)]),
+			Outline:  text(row[fmt.Sprintf(episode.Outline[0], n)]),
+			Contents: text(row[fmt.Sprintf(episode.Contents[0], n)]),
+			Tag:      text(row[fmt.Sprintf(episode.Tag[0], n)]),
+		})
+	}
+
+	ability := excel.Families.Ability
+	for n := 1; n <= count(row, ability.Name, ability.Effect, ability.Interval, ability.EffectTime); n++ {
+		record.Abilities = append(record.Abilities, Ability{
+			Number:     n,
+			Name:       text(row[fmt.Sprintf(ability.Name[0], n)]),
+			Effect:     text(row[fmt.Sprintf(ability.Effect[0], n)]),
+			Interval:   text(row[fmt.Sprintf(ability.Interval[0], n)]),
+			EffectTime: text(row[fmt.Sprintf(ability.EffectTime[0], n)]),
+		})
+	}
+
+	return &record, nil
+}
+
+func count(row map[string]interface{}, families ...[]string) int {
+	max := 0
+
+	for header := range row {
+		for _, patterns := range families {
+			if number, ok := application.Match(patterns[0], header); ok && number > max {
+				max = number
+			}
+		}
+	}
+
+	return max
+}
+
+func text(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprint(v)
+}
+
+type Group struct {
+	Headline string
+	Anchor   string
+	Records  []*Record
+}
+
+func group(setting *Setting, excel *application.Excel, html *application.Html, df *dataframe.DataFrame) ([]Group, error) {
+	groups := make([]Group, len(html.Headlines)+1)
+
+	for i, headline := range html.Headlines {
+		groups[i] = Group{Headline: headline, Anchor: application.Anchor(headline)}
+	}
+	groups[len(html.Headlines)] = Group{Headline: html.Others, Anchor: application.Anchor("")}
+
+	for _, v := range df.Maps() {
+		record, err := decode(excel, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if !record.IsCollected(setting.Collection) {
+			continue
+		}
+
+		index, _ := application.Headline(html.Headlines, record.Furigana)
+		groups[index].Records = append(groups[index].Records, record)
 	}
 
-	f, err := os.Create(setting.Output)
+	return groups, nil
+}
+
+func toc(templates *template.Template, groups []Group, pages []Page, current Page, sb *strings.Builder) error {
+	err := templates.ExecuteTemplate(sb, "Toc.start", nil)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := f.Close(); err != nil {
-			fmt.Println(err)
+
+	for _, group := range groups {
+		if len(group.Records) == 0 || len(group.Headline) == 0 {
+			continue
 		}
-	}()
 
-	_, err = f.WriteString(converted.String())
+		err := templates.ExecuteTemplate(sb, "Toc.item", &View{
+			Headline: group.Headline,
+			Anchor:   group.Anchor,
+			Count:    len(group.Records),
+			Link:     find(pages, group.Anchor).Link(current, group.Anchor),
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-	return err
+	return templates.ExecuteTemplate(sb, "Toc.close", nil)
 }
 
 func setupThreshold(rarity string, html *application.Html) (Threshold, Threshold) {
-	threshold := reflect.ValueOf(html.Threshold).FieldByName(rarity).Interface().(application.Threshold)
+	threshold, _ := html.Threshold.Lookup(rarity)
 
-	hp := Threshold{
-		High: Value{Parameter: threshold.Hp.High, Format: html.Format.Threshold.Higher},
-		Low:  Value{Parameter: threshold.Hp.Low, Format: html.Format.Threshold.Lower},
-	}
+	return tiers(threshold.Hp), tiers(threshold.Attack)
+}
 
-	attack := Threshold{
-		High: Value{Parameter: threshold.Attack.High, Format: html.Format.Threshold.Higher},
-		Low:  Value{Parameter: threshold.Attack.Low, Format: html.Format.Threshold.Lower},
+func tiers(tiers application.Tiers) Threshold {
+	threshold := make(Threshold, len(tiers))
+
+	for i, tier := range tiers {
+		threshold[i] = Tier{Min: tier.Min, Name: tier.Format}
+		if len(tier.Format) > 0 {
+			threshold[i].Template = "threshold." + tier.Format
+		}
 	}
 
-	return hp, attack
+	return threshold
 }
 
 func convert(
 	setting *Setting,
+	excel *application.Excel,
 	html *application.Html,
-	headlines []string,
+	templates *template.Template,
+	cells Cells,
+	codes Codes,
 	hp *Threshold,
 	attack *Threshold,
 	record *Record,
 	sb *strings.Builder,
-) ([]string, error) {
-	headline, headlines := headline(&html.Format.Headline, headlines, record.Furigana)
-
-	if len(headline) > 0 {
-		_, err := sb.WriteString(headline)
-		if err != nil {
-			return headlines, err
-		}
-	}
+) error {
+	sets := record.Sets(setting, excel.Forms, &html.Icon)
 
-	_, err := sb.WriteString(fmt.Sprintf(html.Format.Article.Start, record.Name))
+	injections, err := record.Injections(cells, excel, html, sets)
 	if err != nil {
-		return headlines, err
+		return fmt.Errorf("%s: %s: %w", setting.Sheet, record.Name, err)
 	}
 
-	dataset, err := article(&html.Format, hp, attack, record, record.GetNormalSet(
-		setting,
-		&html.Format,
-		&html.Icon,
-	))
+	name, err := cells.Field("Name", record.Name)
 	if err != nil {
-		return headlines, err
+		return err
 	}
 
-	_, err = sb.WriteString(dataset)
-	if err != nil {
-		return headlines, err
-	}
+	view := View{Name: name}
 
-	awaking := record.GetAwakingSet(setting, &html.Format, &html.Icon)
-	if awaking != nil {
-		dataset, err = article(&html.Format, hp, attack, record, *awaking)
+	if setting.Collection == application.CollectionMark {
+		view.Class, view.Badge, err = collection(templates, html, record)
 		if err != nil {
-			return headlines, err
+			return err
 		}
+	}
 
-		_, err = sb.WriteString(dataset)
-		if err != nil {
-			return headlines, err
-		}
+	err = templates.ExecuteTemplate(sb, "Article.start", &view)
+	if err != nil {
+		return err
 	}
 
-	otherwise := record.GetOtherwiseSet(setting, &html.Format, &html.Icon)
-	if otherwise != nil {
-		dataset, err = article(&html.Format, hp, attack, record, *otherwise)
-		if err != nil {
-			return headlines, err
-		}
+	injections.write(sb, "", Top)
 
-		_, err = sb.WriteString(dataset)
+	for _, set := range sets {
+		err = article(templates, cells, codes, hp, attack, record, set, injections, sb)
 		if err != nil {
-			return headlines, err
+			return err
 		}
 	}
 
-	_, err = sb.WriteString(html.Format.Article.Close)
+	injections.write(sb, "", Bottom)
+
+	err = templates.ExecuteTemplate(sb, "Article.close", nil)
 	if err != nil {
-		return headlines, err
+		return err
 	}
 
-	return headlines, nil
+	return nil
 }
 
-func headline(format *string, _headlines []string, name string) (string, []string) {
-	if len(_headlines) == 0 || len(name) == 0 {
-		return "", _headlines
-	}
-
-	hiragana := strings.Split(name, "")[0]
-	syllabary := ""
-
-	if hiragana < _headlines[0] {
-		return "", _headlines
+func collection(templates *template.Template, html *application.Html, record *Record) (string, interface{}, error) {
+	if record.IsGet() {
+		badge, err := render(templates, "Badge.owned", nil)
+		return html.Collection.Owned, badge, err
 	}
 
-	headlines := make([]string, len(_headlines))
-	copy(headlines, _headlines)
-
-	for _, v := range _headlines {
-		if hiragana >= v {
-			syllabary = v
-			headlines = headlines[:copy(headlines[0:], headlines[1:])]
-		} else {
-			break
-		}
-	}
-
-	return fmt.Sprintf(*format, syllabary), headlines
+	badge, err := render(templates, "Badge.missing", nil)
+	return html.Collection.Missing, badge, err
 }
 
 func article(
-	format *application.Format,
+	templates *template.Template,
+	cells Cells,
+	codes Codes,
 	hp *Threshold,
 	attack *Threshold,
 	record *Record,
 	dataset ArticleSet,
-) (string, error) {
-	var sb strings.Builder
-
-	_, err := sb.WriteString(format.Article.Main.Start)
+	injections Injections,
+	sb *strings.Builder,
+) error {
+	err := templates.ExecuteTemplate(sb, "Article.Main.start", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(reflect.ValueOf(format.Article.Main).FieldByName(fmt.Sprintf("Ribbon%d", dataset.Type)).String())
+	err = templates.ExecuteTemplate(sb, "Article.Main.Ribbon."+dataset.Form, nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Start)
+	injections.write(sb, dataset.Form, FormTop)
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.start", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	detail, err := detail(format, hp, attack, record, dataset)
+	injections.write(sb, dataset.Form, DetailBefore)
+
+	err = detail(templates, cells, codes, hp, attack, record, dataset, sb)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(detail)
+	injections.write(sb, dataset.Form, DetailAfter)
+	injections.write(sb, dataset.Form, AbilityBefore)
+
+	err = abilities(templates, cells, dataset, sb)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Episode.Start)
+	injections.write(sb, dataset.Form, AbilityAfter)
+	injections.write(sb, dataset.Form, EpisodeBefore)
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Episode.start", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	for _, episode := range dataset.Episodes {
-		_, err := sb.WriteString(
-			fmt.Sprintf(
-				format.Article.Main.Profile.Episode.Content,
-				episode.Title,
-				episode.Tag,
-				episode.Contents,
-				episode.Outline,
-			),
-		)
+		err := templates.ExecuteTemplate(sb, "Article.Main.Profile.Episode.content", &View{
+			Episode: cells.Episode(episode),
+		})
 		if err != nil {
-			return "", err
+			return err
 		}
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Episode.Close)
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Episode.close", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Close)
-	if err != nil {
-		return "", err
-	}
+	injections.write(sb, dataset.Form, EpisodeAfter)
 
-	_, err = sb.WriteString(format.Article.Main.Close)
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.close", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return sb.String(), nil
-}
+	injections.write(sb, dataset.Form, FormBottom)
 
-func detail(
-	format *application.Format,
-	hp *Threshold,
-	attack *Threshold,
-	record *Record,
-	dataset ArticleSet,
-) (string, error) {
-	var sb strings.Builder
+	return templates.ExecuteTemplate(sb, "Article.Main.close", nil)
+}
 
-	_, err := sb.WriteString(format.Article.Main.Profile.Detail.Start)
-	if err != nil {
-		return "", err
+func abilities(templates *template.Template, cells Cells, dataset ArticleSet, sb *strings.Builder) error {
+	if len(dataset.Abilities) == 0 {
+		return nil
 	}
 
-	_, err = sb.WriteString(dataset.Icon)
+	err := templates.ExecuteTemplate(sb, "Article.Main.Profile.Ability.start", nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Detail.Personal.Start)
-	if err != nil {
-		return "", err
+	for _, ability := range dataset.Abilities {
+		err := templates.ExecuteTemplate(sb, "Article.Main.Profile.Ability.content", &View{
+			Ability: cells.Ability(ability),
+		})
+		if err != nil {
+			return err
+		}
 	}
 
+	return templates.ExecuteTemplate(sb, "Article.Main.Profile.Ability.close", nil)
+}
+
+func detail(
+	templates *template.Template,
+	cells Cells,
+	codes Codes,
+	hp *Threshold,
+	attack *Threshold,
+	record *Record,
+	dataset ArticleSet,
+	sb *strings.Builder,
+) error {
 	var hpString string
 	switch parameter := dataset.Hp.(type) {
 	case string:
@@ -598,7 +1007,7 @@ func detail(
 	case int:
 		hpString = strconv.Itoa(parameter)
 	default:
-		return "", err
+		return nil
 	}
 
 	var attackString string
@@ -608,41 +1017,85 @@ func detail(
 	case int:
 		attackString = strconv.Itoa(attackParameter)
 	default:
-		return "", err
+		return nil
 	}
 
-	_, err = sb.WriteString(
-		fmt.Sprintf(
-			format.Article.Main.Profile.Detail.Personal.Status,
-			record.AttributeWithHtml(&format.Attribute),
-			record.TypeWithHtml(&format.Type),
-			hp.Html(hpString),
-			attack.Html(attackString),
-		),
-	)
+	status := cells.excel.Families.Status
+
+	name, err := cells.Field("Name", record.Name)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(
-		fmt.Sprintf(
-			format.Article.Main.Profile.Detail.Personal.Profile,
-			dataset.Profile,
-		),
-	)
+	attribute, err := cells.Field("Attribute", record.Attribute)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Detail.Personal.Close)
+	kind, err := cells.Field("Type", record.Type)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	view := View{
+		Name:    name,
+		Profile: cells.Member(status.Profile, dataset.Slot, dataset.Profile),
+		Icon:    dataset.Icon,
 	}
 
-	_, err = sb.WriteString(format.Article.Main.Profile.Detail.Close)
+	view.Attribute, err = codes.Attribute.Html(templates, record.Attribute, attribute)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	view.Type, err = codes.Type.Html(templates, record.Type, kind)
+	if err != nil {
+		return err
+	}
+
+	view.Hp, err = hp.Html(templates, hpString, cells.Member(status.Hp, dataset.Slot, hpString))
+	if err != nil {
+		return err
+	}
+
+	view.Attack, err = attack.Html(templates, attackString, cells.Member(status.Attack, dataset.Slot, attackString))
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.start", &view)
+	if err != nil {
+		return err
+	}
+
+	if len(dataset.Icon) > 0 {
+		err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.icon1", &view)
+	} else {
+		err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.icon2", &view)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.personal.start", &view)
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.personal.status", &view)
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.personal.profile", &view)
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.personal.close", &view)
+	if err != nil {
+		return err
 	}
 
-	return sb.String(), nil
+	return templates.ExecuteTemplate(sb, "Article.Main.Profile.Detail.close", &view)
 }
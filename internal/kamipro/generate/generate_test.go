@@ -0,0 +1,142 @@
+package generate
+
+import (
+	"html/template"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func TestSets(t *testing.T) {
+	forms := []application.Form{
+		{Name: "Normal", Episodes: 2},
+		{Name: "Awaking", Flag: "Awaking", Episodes: 1},
+		{Name: "Otherwise", Flag: "Otherwise", Episodes: 0},
+	}
+
+	tests := []struct {
+		name      string
+		number    int
+		episodes  int
+		flags     map[string]interface{}
+		want      map[string][]int
+		remaining []string
+	}{
+		{
+			name:     "all forms",
+			number:   4,
+			episodes: 4,
+			flags:    map[string]interface{}{"Awaking": "TRUE", "Otherwise": "TRUE"},
+			want:     map[string][]int{"Normal": {1, 2}, "Awaking": {3}, "Otherwise": {4}},
+		},
+		{
+			name:     "last form takes the rest",
+			number:   6,
+			episodes: 6,
+			flags:    map[string]interface{}{"Awaking": "TRUE"},
+			want:     map[string][]int{"Normal": {1, 2}, "Awaking": {3, 4, 5, 6}},
+		},
+		{
+			name:      "beyond the episode number",
+			number:    2,
+			episodes:  3,
+			flags:     map[string]interface{}{"Awaking": "TRUE"},
+			want:      map[string][]int{"Normal": {1, 2}},
+			remaining: []string{"3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := Record{EpisodeNumber: float64(tt.number), values: tt.flags}
+			for n := 1; n <= tt.episodes; n++ {
+				record.Episodes = append(record.Episodes, Episode{Number: n, Title: "Episode" + strconv.Itoa(n)})
+			}
+
+			sets := record.Sets(&Setting{Icon: "%03d"}, forms, &application.Icon{})
+
+			got := map[string][]int{}
+			for _, set := range sets {
+				for _, episode := range set.Episodes {
+					got[set.Form] = append(got[set.Form], episode.Number)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			if remaining := record.Unassigned(sets); !reflect.DeepEqual(remaining, tt.remaining) {
+				t.Errorf("unassigned: got %v, want %v", remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestIconUrl(t *testing.T) {
+	tests := []struct {
+		name string
+		base string
+		want string
+	}{
+		{"absolute", "https://cdn.example.com/kamipro/", "https://cdn.example.com/kamipro/SSR007a.jpg"},
+		{"relative with slash", "images/", "images/SSR007a.jpg"},
+		{"relative without slash", "images", "images/SSR007a.jpg"},
+		{"empty", "", "SSR007a.jpg"},
+	}
+
+	for _, tt := range tests {
+		icon := &application.Icon{BaseUrl: tt.base, Extension: ".jpg"}
+
+		if got := (Record{No: 7}).IconUrl(&Setting{Icon: "SSR%03d"}, icon, "a"); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetsNoData(t *testing.T) {
+	forms := []application.Form{
+		{Name: "Normal", Episodes: 1},
+		{Name: "Awaking", Flag: "Awaking", Episodes: 1, Icon: "a", NoData: true},
+	}
+	icon := &application.Icon{BaseUrl: "/kamipro/", Extension: ".jpg", NoDataDecisionCharacter: "不明"}
+
+	record := Record{
+		No:            1,
+		EpisodeNumber: 2,
+		Episodes:      []Episode{{Number: 1, Title: "不明"}, {Number: 2, Title: "不明"}},
+		values:        map[string]interface{}{"Awaking": "TRUE"},
+	}
+
+	sets := record.Sets(&Setting{Icon: "SSR%03d"}, forms, icon)
+	if len(sets) != 2 {
+		t.Fatalf("got %d sets, want 2", len(sets))
+	}
+
+	if sets[0].Icon != "/kamipro/SSR001.jpg" {
+		t.Errorf("Normal: got %q, want the icon without no_data", sets[0].Icon)
+	}
+
+	if sets[1].Icon != "" {
+		t.Errorf("Awaking: got %q, want no icon", sets[1].Icon)
+	}
+}
+
+func TestCellsField(t *testing.T) {
+	excel := &application.Excel{Columns: map[string][]string{"Name": {"神姫名"}, "Html1": {"HTML1"}}}
+	cells := NewCells(excel, []string{"HTML1"})
+
+	if v, err := cells.Field("Name", "<b>"); err != nil || v != "<b>" {
+		t.Errorf("Name: got %#v, %v", v, err)
+	}
+
+	if v, err := cells.Field("Html1", "<b>"); err != nil || v != template.HTML("<b>") {
+		t.Errorf("Html1: got %#v, %v", v, err)
+	}
+
+	if _, err := cells.Field("Attribute", "火"); err == nil {
+		t.Error("Attribute: got no error for a field without a column")
+	}
+}
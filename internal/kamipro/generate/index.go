@@ -0,0 +1,61 @@
+package generate
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func Index(html *application.Html, sink Sink, output string, results []*Result) error {
+	var sb strings.Builder
+
+	templates, err := html.Format.Templates()
+	if err != nil {
+		return err
+	}
+
+	document, err := NewDocument(html, html.Document.Title)
+	if err != nil {
+		return err
+	}
+
+	err = document.start(templates, &sb)
+	if err != nil {
+		return err
+	}
+
+	err = templates.ExecuteTemplate(&sb, "Index.start", nil)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		link, err := filepath.Rel(filepath.Dir(output), result.Output)
+		if err != nil {
+			link = result.Output
+		}
+
+		err = templates.ExecuteTemplate(&sb, "Index.item", &View{
+			Sheet:  result.Sheet,
+			Rarity: result.Rarity,
+			Count:  result.Count,
+			Link:   filepath.ToSlash(link),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = templates.ExecuteTemplate(&sb, "Index.close", nil)
+	if err != nil {
+		return err
+	}
+
+	err = document.close(templates, &sb)
+	if err != nil {
+		return err
+	}
+
+	return sink.Write(output, sb.String())
+}
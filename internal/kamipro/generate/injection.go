@@ -0,0 +1,115 @@
+package generate
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+const (
+	Top           = "top"
+	Bottom        = "bottom"
+	FormTop       = "form.top"
+	DetailBefore  = "detail.before"
+	DetailAfter   = "detail.after"
+	AbilityBefore = "ability.before"
+	AbilityAfter  = "ability.after"
+	EpisodeBefore = "episode.before"
+	EpisodeAfter  = "episode.after"
+	FormBottom    = "form.bottom"
+	FormSeparator = ":"
+)
+
+var (
+	articlePoints = []string{Top, Bottom}
+	formPoints    = []string{FormTop, DetailBefore, DetailAfter, AbilityBefore, AbilityAfter, EpisodeBefore, EpisodeAfter, FormBottom}
+)
+
+type Injections map[string][]interface{}
+
+func (r Record) Injections(cells Cells, excel *application.Excel, html *application.Html, sets []ArticleSet) (Injections, error) {
+	injections := Injections{}
+
+	pairs := [][4]string{
+		{"Html1", r.Html1, "HtmlDestination1", r.HtmlDestination1},
+		{"Html2", r.Html2, "HtmlDestination2", r.HtmlDestination2},
+	}
+
+	for _, pair := range pairs {
+		value, destination := pair[1], strings.TrimSpace(pair[3])
+		if blank(html.Injection.Blank, value) || blank(html.Injection.Blank, destination) {
+			continue
+		}
+
+		key, err := resolve(excel, sets, destination)
+		if err != nil {
+			column := pair[2]
+			if aliases := excel.Columns[pair[2]]; len(aliases) > 0 {
+				column = aliases[0]
+			}
+
+			return nil, fmt.Errorf("%s: %w", column, err)
+		}
+
+		cell, err := cells.Field(pair[0], value)
+		if err != nil {
+			return nil, err
+		}
+
+		injections[key] = append(injections[key], cell)
+	}
+
+	return injections, nil
+}
+
+func resolve(excel *application.Excel, sets []ArticleSet, destination string) (string, error) {
+	form, point, qualified := strings.Cut(destination, FormSeparator)
+	if !qualified {
+		form, point = "", destination
+	}
+
+	switch {
+	case !qualified && contains(articlePoints, point):
+		return point, nil
+	case !contains(formPoints, point):
+		return "", fmt.Errorf("unknown destination %q, expected one of %s or [<form>%s]%s",
+			destination, strings.Join(articlePoints, ", "), FormSeparator, strings.Join(formPoints, ", "))
+	case qualified && !contains(excel.FormNames(), form):
+		return "", fmt.Errorf("unknown form %q in destination %q", form, destination)
+	case !qualified && len(sets) > 0:
+		form = sets[0].Form
+	}
+
+	return form + FormSeparator + point, nil
+}
+
+func (i Injections) write(sb *strings.Builder, form string, point string) {
+	key := point
+	if len(form) > 0 {
+		key = form + FormSeparator + point
+	}
+
+	for _, v := range i[key] {
+		if raw, ok := v.(template.HTML); ok {
+			sb.WriteString(string(raw))
+		} else {
+			sb.WriteString(template.HTMLEscapeString(fmt.Sprint(v)))
+		}
+	}
+}
+
+func blank(values []string, value string) bool {
+	return len(strings.TrimSpace(value)) == 0 || contains(values, strings.TrimSpace(value))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,43 @@
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func TestResolve(t *testing.T) {
+	excel := &application.Excel{Forms: []application.Form{{Name: "Normal"}, {Name: "Awaking"}}}
+	sets := []ArticleSet{{Form: "Normal"}, {Form: "Awaking"}}
+
+	tests := []struct {
+		destination string
+		sets        []ArticleSet
+		want        string
+		err         string
+	}{
+		{destination: "top", sets: sets, want: "top"},
+		{destination: "bottom", sets: sets, want: "bottom"},
+		{destination: "detail.before", sets: sets, want: "Normal:detail.before"},
+		{destination: "detail.before", want: ":detail.before"},
+		{destination: "Awaking:episode.after", sets: sets, want: "Awaking:episode.after"},
+		{destination: "Awaking:top", sets: sets, err: "unknown destination"},
+		{destination: "middle", sets: sets, err: "unknown destination"},
+		{destination: "Otherwise:form.top", sets: sets, err: "unknown form"},
+	}
+
+	for _, tt := range tests {
+		got, err := resolve(excel, tt.sets, tt.destination)
+		if len(tt.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: got %q, %v, want error %q", tt.destination, got, err, tt.err)
+			}
+			continue
+		}
+
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v, want %q", tt.destination, got, err, tt.want)
+		}
+	}
+}
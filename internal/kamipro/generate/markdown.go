@@ -0,0 +1,178 @@
+package generate
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+var escaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", ">", "&gt;",
+	"\\", "\\\\", "`", "\\`", "*", "\\*", "_", "\\_", "[", "\\[", "]", "\\]", "#", "\\#", "|", "\\|", "~", "\\~",
+)
+
+var (
+	marker  = regexp.MustCompile(`^([-+=])`)
+	ordered = regexp.MustCompile(`^(\d+)([.)])`)
+)
+
+func exportMarkdown(setting *Setting, excel *application.Excel, html *application.Html, groups []Group) (string, error) {
+	var sb strings.Builder
+
+	cells := NewCells(excel, html.Raw)
+
+	fmt.Fprintf(&sb, "# %s\n", line(setting.Title))
+
+	for _, group := range groups {
+		if len(group.Records) == 0 {
+			continue
+		}
+
+		if len(group.Headline) > 0 {
+			fmt.Fprintf(&sb, "\n## %s\n", line(group.Headline))
+		}
+
+		for _, record := range group.Records {
+			err := markdown(setting, excel, html, cells, record, &sb)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func markdown(setting *Setting, excel *application.Excel, html *application.Html, cells Cells, record *Record, sb *strings.Builder) error {
+	sets := record.Sets(setting, excel.Forms, &html.Icon)
+	status := excel.Families.Status
+
+	name, err := cells.Field("Name", record.Name)
+	if err != nil {
+		return err
+	}
+
+	attribute, err := cells.Field("Attribute", record.Attribute)
+	if err != nil {
+		return err
+	}
+
+	kind, err := cells.Field("Type", record.Type)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(sb, "\n### %s\n", line(name))
+
+	sb.WriteString("\n| Form | 属性 | TYPE | HP | ATTACK |\n| --- | --- | --- | --- | --- |\n")
+
+	for _, set := range sets {
+		fmt.Fprintf(sb, "| %s | %s | %s | %s | %s |\n",
+			cell(set.Form),
+			cell(attribute),
+			cell(kind),
+			cell(cells.Member(status.Hp, set.Slot, text(set.Hp))),
+			cell(cells.Member(status.Attack, set.Slot, text(set.Attack))),
+		)
+	}
+
+	for _, set := range sets {
+		fmt.Fprintf(sb, "\n#### %s\n", line(set.Form))
+
+		if len(set.Icon) > 0 {
+			fmt.Fprintf(sb, "\n![%s](%s)\n", line(name), link(set.Icon))
+		}
+
+		if len(set.Profile) > 0 {
+			fmt.Fprintf(sb, "\n%s\n", paragraph(cells.Member(status.Profile, set.Slot, set.Profile)))
+		}
+
+		if len(set.Abilities) > 0 {
+			sb.WriteString("\n")
+		}
+
+		for _, ability := range set.Abilities {
+			view := cells.Ability(ability)
+
+			fmt.Fprintf(sb, "- **%s** %s (使用間隔 %s / 効果時間 %s)\n",
+				line(view.Name), line(view.Effect), line(view.Interval), line(view.EffectTime),
+			)
+		}
+
+		for _, episode := range set.Episodes {
+			view := cells.Episode(episode)
+
+			fmt.Fprintf(sb, "\n##### %s\n", line(view.Title))
+
+			if len(episode.Tag) > 0 {
+				fmt.Fprintf(sb, "\n*%s*\n", line(view.Tag))
+			}
+
+			if len(episode.Outline) > 0 {
+				fmt.Fprintf(sb, "\n%s\n", paragraph(view.Outline))
+			}
+
+			if len(episode.Contents) > 0 {
+				fmt.Fprintf(sb, "\n> %s\n", strings.ReplaceAll(paragraph(view.Contents), "\n", "\n> "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func escape(value interface{}) string {
+	if raw, ok := value.(template.HTML); ok {
+		return string(raw)
+	}
+
+	text := escaper.Replace(fmt.Sprint(value))
+	text = marker.ReplaceAllString(text, "\\$1")
+
+	return ordered.ReplaceAllString(text, "$1\\$2")
+}
+
+func line(value interface{}) string {
+	if raw, ok := value.(template.HTML); ok {
+		return strings.Join(strings.Fields(string(raw)), " ")
+	}
+
+	return escape(strings.Join(strings.Fields(fmt.Sprint(value)), " "))
+}
+
+func cell(value interface{}) string {
+	text := line(value)
+
+	if _, ok := value.(template.HTML); ok {
+		return strings.ReplaceAll(text, "|", "\\|")
+	}
+
+	return text
+}
+
+func paragraph(value interface{}) string {
+	raw, isRaw := value.(template.HTML)
+
+	text := fmt.Sprint(value)
+	if isRaw {
+		text = string(raw)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(strings.TrimSpace(text), "\r\n", "\n"), "\n")
+	for i, v := range lines {
+		lines[i] = strings.TrimSpace(v)
+
+		if !isRaw {
+			lines[i] = escape(lines[i])
+		}
+	}
+
+	return strings.Join(lines, "  \n")
+}
+
+func link(value string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E").Replace(value)
+}
@@ -0,0 +1,98 @@
+package generate
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func TestMarkdownEscape(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(interface{}) string
+		value interface{}
+		want  string
+	}{
+		{"pipe", cell, "a|b", `a\|b`},
+		{"emphasis", cell, "*a* _b_", `\*a\* \_b\_`},
+		{"script", cell, "<script>alert(1)</script>", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{"newline in cell", cell, "a\nb\r\nc", "a b c"},
+		{"link", line, "[a](b) #1 `c` ~d~", "\\[a\\](b) \\#1 \\`c\\` \\~d\\~"},
+		{"list marker", line, "1. a", `1\. a`},
+		{"quote marker", line, "- a", `\- a`},
+		{"backslash", line, `a\b`, `a\\b`},
+		{"paragraph", paragraph, "  *a*\n <b>b</b> ", "\\*a\\*  \n&lt;b&gt;b&lt;/b&gt;"},
+		{"raw cell", cell, template.HTML("<b>a|b</b>\n*c*"), `<b>a\|b</b> *c*`},
+		{"raw line", line, template.HTML("<b>*a*</b>"), "<b>*a*</b>"},
+		{"raw paragraph", paragraph, template.HTML("<b>a</b>\n<i>b</i>"), "<b>a</b>  \n<i>b</i>"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(tt.value); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCellsEscape(t *testing.T) {
+	app, err := application.New("", nil, Fields())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	excel, html := &app.Excel, &app.Html
+	html.Exports = []string{application.ExportMarkdown}
+
+	templates, err := html.Format.Templates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := &Record{
+		No:               1,
+		Name:             "a|b *c* <script>alert(1)</script>\nd",
+		Furigana:         "あ",
+		EpisodeNumber:    1,
+		Html1:            "<b class=\"raw\">*raw*</b>",
+		HtmlDestination1: "top",
+		Statuses:         []Status{{Number: 1, Hp: "1", Attack: "1", Profile: "<i>p</i>"}},
+		Episodes:         []Episode{{Number: 1, Title: "<u>t</u>"}},
+	}
+	setting := &Setting{Sheet: "SSR", Rarity: "SSR", Icon: "SSR%03d", Output: "SSR.html", Title: "SSR", Sink: &Buffer{}}
+	hp, attack := setupThreshold(setting.Rarity, html)
+
+	var sb strings.Builder
+
+	err = convert(setting, excel, html, templates, NewCells(excel, html.Raw), NewCodes(html), &hp, &attack, record, &sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"a|b *c* &lt;script&gt;alert(1)&lt;/script&gt;\nd",
+		"&lt;i&gt;p&lt;/i&gt;",
+		"&lt;u&gt;t&lt;/u&gt;",
+		"<b class=\"raw\">*raw*</b>",
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("html: %q not found in %s", want, sb.String())
+		}
+	}
+
+	markdown, err := exportMarkdown(setting, excel, html, []Group{{Headline: "あ", Records: []*Record{record}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"### a\\|b \\*c\\* &lt;script&gt;alert(1)&lt;/script&gt; d\n",
+		"&lt;i&gt;p&lt;/i&gt;",
+		"&lt;u&gt;t&lt;/u&gt;",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("markdown: %q not found in %s", want, markdown)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package generate
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+type Page struct {
+	Number int
+	Output string
+	Groups []Group
+}
+
+func paginate(setting *Setting, pagination *application.Pagination, groups []Group) []Page {
+	var pages []Page
+
+	switch pagination.Mode {
+	case application.PaginationHeadline:
+		for _, group := range groups {
+			if len(group.Records) > 0 {
+				pages = append(pages, Page{Groups: []Group{group}})
+			}
+		}
+	case application.PaginationCount:
+		var page Page
+		size := 0
+
+		for _, group := range groups {
+			records := group.Records
+
+			for len(records) > 0 {
+				n := pagination.Size - size
+				if n > len(records) {
+					n = len(records)
+				}
+
+				page.Groups = append(page.Groups, Group{Headline: group.Headline, Anchor: group.Anchor, Records: records[:n]})
+				records = records[n:]
+				size += n
+
+				if size == pagination.Size {
+					pages = append(pages, page)
+					page, size = Page{}, 0
+				}
+			}
+		}
+
+		if size > 0 {
+			pages = append(pages, page)
+		}
+	}
+
+	if len(pages) <= 1 {
+		return []Page{{Number: 1, Output: setting.Output, Groups: groups}}
+	}
+
+	extension := filepath.Ext(setting.Output)
+	base := strings.TrimSuffix(setting.Output, extension)
+
+	for i := range pages {
+		pages[i].Number = i + 1
+		pages[i].Output = fmt.Sprintf(pagination.Name, base, i+1) + extension
+	}
+
+	return pages
+}
+
+func (p Page) Link(current Page, anchor string) string {
+	link := ""
+	if p.Number != current.Number {
+		link = filepath.Base(p.Output)
+	}
+
+	if len(anchor) > 0 {
+		link += "#" + anchor
+	}
+
+	return link
+}
+
+func find(pages []Page, anchor string) Page {
+	for _, page := range pages {
+		for _, group := range page.Groups {
+			if group.Anchor == anchor && len(group.Records) > 0 {
+				return page
+			}
+		}
+	}
+
+	return pages[0]
+}
+
+func navigation(templates *template.Template, pages []Page, current Page, sb *strings.Builder) error {
+	if len(pages) <= 1 {
+		return nil
+	}
+
+	view := View{Page: current.Number, Pages: len(pages)}
+
+	err := templates.ExecuteTemplate(sb, "Pagination.start", &view)
+	if err != nil {
+		return err
+	}
+
+	if current.Number > 1 {
+		view.Link = pages[current.Number-2].Link(current, "")
+
+		err = templates.ExecuteTemplate(sb, "Pagination.previous", &view)
+		if err != nil {
+			return err
+		}
+	}
+
+	if current.Number < len(pages) {
+		view.Link = pages[current.Number].Link(current, "")
+
+		err = templates.ExecuteTemplate(sb, "Pagination.next", &view)
+		if err != nil {
+			return err
+		}
+	}
+
+	view.Link = ""
+
+	return templates.ExecuteTemplate(sb, "Pagination.close", &view)
+}
@@ -0,0 +1,161 @@
+package generate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+)
+
+func records(n int) []*Record {
+	records := make([]*Record, n)
+	for i := range records {
+		records[i] = &Record{No: i + 1}
+	}
+
+	return records
+}
+
+func TestPaginate(t *testing.T) {
+	setting := &Setting{Output: "out/SSR.html"}
+
+	tests := []struct {
+		name       string
+		pagination application.Pagination
+		groups     []Group
+		want       []string
+		sizes      []int
+	}{
+		{
+			name:       "none",
+			pagination: application.Pagination{Mode: application.PaginationNone, Size: 2, Name: "%s-%d"},
+			groups:     []Group{{Anchor: "a", Records: records(5)}},
+			want:       []string{"out/SSR.html"},
+			sizes:      []int{5},
+		},
+		{
+			name:       "no rows",
+			pagination: application.Pagination{Mode: application.PaginationCount, Size: 2, Name: "%s-%d"},
+			groups:     []Group{{Anchor: "a"}, {Anchor: "ka"}},
+			want:       []string{"out/SSR.html"},
+			sizes:      []int{0},
+		},
+		{
+			name:       "exactly size",
+			pagination: application.Pagination{Mode: application.PaginationCount, Size: 3, Name: "%s-%d"},
+			groups:     []Group{{Anchor: "a", Records: records(2)}, {Anchor: "ka", Records: records(1)}},
+			want:       []string{"out/SSR.html"},
+			sizes:      []int{3},
+		},
+		{
+			name:       "size plus one",
+			pagination: application.Pagination{Mode: application.PaginationCount, Size: 3, Name: "%s-%d"},
+			groups:     []Group{{Anchor: "a", Records: records(2)}, {Anchor: "ka", Records: records(2)}},
+			want:       []string{"out/SSR-1.html", "out/SSR-2.html"},
+			sizes:      []int{3, 1},
+		},
+		{
+			name:       "name",
+			pagination: application.Pagination{Mode: application.PaginationCount, Size: 1, Name: "%s_page%03d"},
+			groups:     []Group{{Anchor: "a", Records: records(2)}},
+			want:       []string{"out/SSR_page001.html", "out/SSR_page002.html"},
+			sizes:      []int{1, 1},
+		},
+		{
+			name:       "headline",
+			pagination: application.Pagination{Mode: application.PaginationHeadline, Name: "%s-%d"},
+			groups:     []Group{{Anchor: "a", Records: records(4)}, {Anchor: "ka"}, {Anchor: "sa", Records: records(1)}},
+			want:       []string{"out/SSR-1.html", "out/SSR-2.html"},
+			sizes:      []int{4, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := paginate(setting, &tt.pagination, tt.groups)
+
+			var outputs []string
+			var sizes []int
+
+			for i, page := range pages {
+				if page.Number != i+1 {
+					t.Errorf("page %d: got number %d", i, page.Number)
+				}
+
+				size := 0
+				for _, group := range page.Groups {
+					size += len(group.Records)
+				}
+
+				outputs = append(outputs, page.Output)
+				sizes = append(sizes, size)
+			}
+
+			if !reflect.DeepEqual(outputs, tt.want) || !reflect.DeepEqual(sizes, tt.sizes) {
+				t.Errorf("got %v %v, want %v %v", outputs, sizes, tt.want, tt.sizes)
+			}
+		})
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	templates, err := application.Format{
+		Pagination: application.FormatPagination{
+			Start:    "[{{.Page}}/{{.Pages}}",
+			Close:    "]",
+			Previous: "<a rel=\"prev\" href=\"{{.Link}}\"></a>",
+			Next:     "<a rel=\"next\" href=\"{{.Link}}\"></a>",
+		},
+	}.Templates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pages := paginate(
+		&Setting{Output: "out/SSR.html"},
+		&application.Pagination{Mode: application.PaginationCount, Size: 1, Name: "%s-%d"},
+		[]Group{{Anchor: "a", Records: records(3)}},
+	)
+
+	tests := []struct {
+		page int
+		want string
+	}{
+		{1, `[1/3<a rel="next" href="SSR-2.html"></a>]`},
+		{2, `[2/3<a rel="prev" href="SSR-1.html"></a><a rel="next" href="SSR-3.html"></a>]`},
+		{3, `[3/3<a rel="prev" href="SSR-2.html"></a>]`},
+	}
+
+	for _, tt := range tests {
+		var sb strings.Builder
+
+		err := navigation(templates, pages, pages[tt.page-1], &sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if sb.String() != tt.want {
+			t.Errorf("page %d: got %q, want %q", tt.page, sb.String(), tt.want)
+		}
+	}
+
+	var sb strings.Builder
+
+	err = navigation(templates, pages[:1], pages[0], &sb)
+	if err != nil || sb.Len() > 0 {
+		t.Errorf("single page: got %q, %v, want no navigation", sb.String(), err)
+	}
+}
+
+func TestLink(t *testing.T) {
+	pages := []Page{{Number: 1, Output: "out/SSR-1.html"}, {Number: 2, Output: "out/SSR-2.html"}}
+
+	if got := pages[1].Link(pages[0], "headline-ka"); got != "SSR-2.html#headline-ka" {
+		t.Errorf("got %q, want SSR-2.html#headline-ka", got)
+	}
+
+	if got := pages[0].Link(pages[0], "headline-a"); got != "#headline-a" {
+		t.Errorf("got %q, want #headline-a", got)
+	}
+}
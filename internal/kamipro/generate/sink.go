@@ -0,0 +1,58 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+type Sink interface {
+	Write(output string, text string) error
+}
+
+type Files struct{}
+
+func (Files) Write(output string, text string) error {
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	_, err = f.WriteString(text)
+
+	return err
+}
+
+type File struct {
+	Output string
+	Text   string
+}
+
+type Buffer struct {
+	mu    sync.Mutex
+	files []File
+}
+
+func (b *Buffer) Write(output string, text string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.files = append(b.files, File{Output: output, Text: text})
+
+	return nil
+}
+
+func (b *Buffer) Files() []File {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	files := make([]File, len(b.files))
+	copy(files, b.files)
+
+	return files
+}
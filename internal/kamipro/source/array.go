@@ -0,0 +1,104 @@
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+func array(r io.Reader, name string, skip int) ([][]string, error) {
+	var items []interface{}
+
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	err := decoder.Decode(&items)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	if len(items) == 0 {
+		return [][]string{}, nil
+	}
+
+	if _, ok := items[0].(map[string]interface{}); ok {
+		rows, err := objects(items)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		return tidy(rows), nil
+	}
+
+	var rows [][]string
+
+	for i, item := range items {
+		values, ok := item.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: [%d] must be an array of values", name, i)
+		}
+
+		row := make([]string, len(values))
+		for j, v := range values {
+			row[j] = value(v)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return tidy(trim(rows, skip)), nil
+}
+
+func objects(items []interface{}) ([][]string, error) {
+	found := map[string]bool{}
+	var header []string
+
+	for i, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[%d] must be an object", i)
+		}
+
+		for k := range object {
+			if !found[k] {
+				found[k] = true
+				header = append(header, k)
+			}
+		}
+	}
+
+	sort.Strings(header)
+
+	rows := [][]string{header}
+
+	for _, item := range items {
+		object := item.(map[string]interface{})
+
+		row := make([]string, len(header))
+		for i, k := range header {
+			row[i] = value(object[k])
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func value(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strings.ToUpper(fmt.Sprint(v))
+	case json.Number:
+		return v.String()
+	default:
+		text, _ := json.Marshal(v)
+		return string(text)
+	}
+}
@@ -0,0 +1,179 @@
+package source
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const Standard = "-"
+
+var ErrNotFound = errors.New("sheet not found")
+
+type Source interface {
+	Rows(sheet string, skip int) ([][]string, error)
+	Close() error
+}
+
+func Open(path string) (Source, error) {
+	if path == Standard {
+		return Read(os.Stdin)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &Directory{path: path}, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv", ".tsv", ".json":
+		return &File{path: path}, nil
+	}
+
+	return OpenWorkbook(path)
+}
+
+func Read(r io.Reader) (Source, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return ReadWorkbook(bytes.NewReader(data))
+	}
+
+	kind := ".csv"
+	if trimmed := bytes.TrimSpace(data); bytes.HasPrefix(trimmed, []byte("[")) {
+		kind = ".json"
+	} else if line, _, _ := bytes.Cut(data, []byte("\n")); bytes.Contains(line, []byte("\t")) {
+		kind = ".tsv"
+	}
+
+	return &Stream{data: data, kind: kind}, nil
+}
+
+type Directory struct {
+	path string
+}
+
+func (d *Directory) Rows(sheet string, skip int) ([][]string, error) {
+	for _, extension := range []string{".csv", ".tsv", ".json"} {
+		path := filepath.Join(d.path, sheet+extension)
+		if _, err := os.Stat(path); err == nil {
+			return read(path, skip)
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w, no %s.csv, %s.tsv or %s.json in %s", sheet, ErrNotFound, sheet, sheet, sheet, d.path)
+}
+
+func (d *Directory) Inputs() []string {
+	var inputs []string
+
+	for _, extension := range []string{".csv", ".tsv", ".json"} {
+		paths, _ := filepath.Glob(filepath.Join(d.path, "*"+extension))
+		inputs = append(inputs, paths...)
+	}
+
+	return inputs
+}
+
+func (d *Directory) Close() error {
+	return nil
+}
+
+type File struct {
+	path string
+}
+
+func (f *File) Rows(sheet string, skip int) ([][]string, error) {
+	name := filepath.Base(f.path)
+	if strings.TrimSuffix(name, filepath.Ext(name)) != sheet {
+		return nil, fmt.Errorf("%s: %w, %s only has %s", sheet, ErrNotFound, name, strings.TrimSuffix(name, filepath.Ext(name)))
+	}
+
+	return read(f.path, skip)
+}
+
+func (f *File) Inputs() []string {
+	return []string{f.path}
+}
+
+func (f *File) Close() error {
+	return nil
+}
+
+type Stream struct {
+	data []byte
+	kind string
+}
+
+func (s *Stream) Rows(sheet string, skip int) ([][]string, error) {
+	return parse(bytes.NewReader(s.data), "stdin", s.kind, skip)
+}
+
+func (s *Stream) Close() error {
+	return nil
+}
+
+func read(path string, skip int) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parse(f, path, strings.ToLower(filepath.Ext(path)), skip)
+}
+
+func parse(r io.Reader, name string, kind string, skip int) ([][]string, error) {
+	switch kind {
+	case ".csv":
+		return delimited(r, name, ',', skip)
+	case ".tsv":
+		return delimited(r, name, '\t', skip)
+	default:
+		return array(r, name, skip)
+	}
+}
+
+func trim(rows [][]string, skip int) [][]string {
+	if skip >= len(rows) {
+		return [][]string{}
+	}
+
+	return rows[skip:]
+}
+
+func tidy(rows [][]string) [][]string {
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	for i, row := range rows {
+		for j, v := range row {
+			if strings.EqualFold(v, "true") || strings.EqualFold(v, "false") {
+				row[j] = strings.ToUpper(v)
+			}
+		}
+
+		for len(row) < width {
+			row = append(row, "")
+		}
+		rows[i] = row
+	}
+
+	return rows
+}
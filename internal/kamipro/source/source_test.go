@@ -0,0 +1,157 @@
+package source
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"github.com/xuri/excelize/v2"
+)
+
+func workbook(t *testing.T) []byte {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	err := f.SetSheetRow("Sheet1", "A1", &[]interface{}{"No", "取得フラグ"})
+	if err == nil {
+		err = f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, true})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		kind string
+		want [][]string
+	}{
+		{"csv", "No,Name\n1,a\tb\n", ".csv", [][]string{{"No", "Name"}, {"1", "a\tb"}}},
+		{"csv with bom", "\uFEFFNo,Name\n1,a\n", ".csv", [][]string{{"No", "Name"}, {"1", "a"}}},
+		{"tsv", "No\tName\n1\ta,b\n", ".tsv", [][]string{{"No", "Name"}, {"1", "a,b"}}},
+		{"json arrays", "  [[\"No\", \"Name\"], [1, \"a\"]]", ".json", [][]string{{"No", "Name"}, {"1", "a"}}},
+		{"json objects", "[{\"No\": 1, \"Name\": \"a\"}, {\"Name\": \"b\", \"Flag\": true}]", ".json", [][]string{{"Flag", "Name", "No"}, {"", "a", "1"}, {"TRUE", "b", ""}}},
+		{"xlsx", string(workbook(t)), "", [][]string{{"No", "取得フラグ"}, {"1", "TRUE"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := Read(strings.NewReader(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer src.Close()
+
+			switch s := src.(type) {
+			case *Stream:
+				if s.kind != tt.kind {
+					t.Errorf("got %s, want %s", s.kind, tt.kind)
+				}
+			case *Workbook:
+				if len(tt.kind) > 0 {
+					t.Errorf("got a workbook, want %s", tt.kind)
+				}
+			}
+
+			rows, err := src.Rows("Sheet1", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(rows, tt.want) {
+				t.Errorf("got %q, want %q", rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadSkip(t *testing.T) {
+	src, err := Read(strings.NewReader("title\n\"\"\nNo,Name\n1,a\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := src.Rows("Sheet1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := [][]string{{"No", "Name"}, {"1", "a"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %q, want %q", rows, want)
+	}
+
+	rows, err = src.Rows("Sheet1", 10)
+	if err != nil || len(rows) != 0 {
+		t.Errorf("got %q, %v, want no rows", rows, err)
+	}
+}
+
+func TestTidy(t *testing.T) {
+	rows := tidy([][]string{
+		{"Flag", "Other", "Name"},
+		{"true", "yes"},
+		{"True", "False", "TRUE"},
+		{"TRUE", "fAlSe", "truth"},
+	})
+
+	want := [][]string{
+		{"Flag", "Other", "Name"},
+		{"TRUE", "yes", ""},
+		{"TRUE", "FALSE", "TRUE"},
+		{"TRUE", "FALSE", "truth"},
+	}
+
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("got %q, want %q", rows, want)
+	}
+
+	df := dataframe.LoadRecords(rows)
+
+	if types := df.Types(); types[0] != series.String {
+		t.Errorf("Flag: got %s, want %s so that flags compare with TRUE", types[0], series.String)
+	}
+
+	if got := df.Col("Flag").Records(); !reflect.DeepEqual(got, []string{"TRUE", "TRUE", "TRUE"}) {
+		t.Errorf("Flag: got %q", got)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "SSR.csv"), []byte("No\n1\n"), 0644)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "book.xlsx"), workbook(t), 0644)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{dir, filepath.Join(dir, "SSR.csv"), filepath.Join(dir, "book.xlsx")} {
+		src, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = src.Rows("SR", 0)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: got %v, want ErrNotFound", path, err)
+		}
+
+		src.Close()
+	}
+}
@@ -0,0 +1,56 @@
+package source
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type Workbook struct {
+	file *excelize.File
+	path string
+}
+
+func OpenWorkbook(path string) (*Workbook, error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Workbook{file: f, path: path}, nil
+}
+
+func ReadWorkbook(r io.Reader) (*Workbook, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Workbook{file: f}, nil
+}
+
+func (w *Workbook) Rows(sheet string, skip int) ([][]string, error) {
+	rows, err := w.file.GetRows(sheet)
+	if errors.As(err, &excelize.ErrSheetNotExist{}) {
+		return nil, fmt.Errorf("%s: %w", sheet, ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tidy(trim(rows, skip)), nil
+}
+
+func (w *Workbook) Inputs() []string {
+	if len(w.path) == 0 {
+		return nil
+	}
+
+	return []string{w.path}
+}
+
+func (w *Workbook) Close() error {
+	return w.file.Close()
+}
@@ -0,0 +1,291 @@
+// Package xlsx2html generates HTML, JSON and Markdown from the character sheets of a workbook.
+package xlsx2html
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/application"
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/generate"
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/source"
+	"golang.org/x/sync/errgroup"
+)
+
+type (
+	// Application is the configuration, loaded by LoadApplication.
+	Application   = application.Application
+	Excel         = application.Excel
+	Html          = application.Html
+	Dataset       = application.Dataset
+	Sort          = application.Sort
+	Skip          = application.Skip
+	Form          = application.Form
+	Families      = application.Families
+	StatusFamily  = application.StatusFamily
+	EpisodeFamily = application.EpisodeFamily
+	AbilityFamily = application.AbilityFamily
+	Thresholds    = application.Thresholds
+	Threshold     = application.Threshold
+	Tiers         = application.Tiers
+	Tier          = application.Tier
+	// Problems is returned by LoadApplication for an invalid configuration.
+	Problems = application.Problems
+	Problem  = application.Problem
+
+	// Source gives the rows of each sheet.
+	Source = source.Source
+
+	// Sink receives every output file by its path.
+	Sink = generate.Sink
+	// Files writes the outputs to the file system.
+	Files = generate.Files
+	// Buffer keeps the outputs in memory.
+	Buffer = generate.Buffer
+	File   = generate.File
+
+	// Result describes the output of a dataset.
+	Result = generate.Result
+)
+
+type Options struct {
+	// Workbook is read as an xlsx workbook, or a CSV, TSV or JSON text of a single sheet,
+	// which requires Datasets to select exactly one dataset.
+	Workbook io.Reader
+	// Source is used instead of Workbook when set.
+	Source Source
+	// Application is the embedded default when nil, and is validated as LoadApplication does.
+	Application *Application
+	// Sink is Files when nil.
+	Sink Sink
+	// Output is the directory joined with the output of each dataset.
+	Output string
+	// Datasets selects the datasets by sheet, all of them when empty.
+	Datasets []string
+}
+
+type Results struct {
+	Datasets []*Result
+	Index    string
+	// Warnings lists the datasets skipped because the source does not have their sheet.
+	Warnings []string
+}
+
+// ErrSheetNotFound is reported by a Source without the sheet of a dataset.
+var ErrSheetNotFound = source.ErrNotFound
+
+// LoadApplication merges the embedded default, the file at path (when not empty),
+// environment variables and KEY=VALUE overrides, and validates the result.
+func LoadApplication(path string, overrides []string) (*Application, error) {
+	return application.New(path, overrides, generate.Fields())
+}
+
+// OpenSource opens a workbook, a CSV, TSV or JSON file, or a directory of them.
+// "-" reads the standard input as ReadSource.
+func OpenSource(path string) (Source, error) {
+	return source.Open(path)
+}
+
+// ReadSource reads a workbook, or a CSV, TSV or JSON text of a single sheet.
+func ReadSource(r io.Reader) (Source, error) {
+	return source.Read(r)
+}
+
+// Stylesheet returns the default stylesheet for the embedded markup.
+func Stylesheet() ([]byte, error) {
+	return application.Stylesheet()
+}
+
+// Generate writes the outputs of every dataset of the application to the sink.
+func Generate(ctx context.Context, options Options) (*Results, error) {
+	app := options.Application
+	if app == nil {
+		loaded, err := LoadApplication("", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		app = loaded
+	}
+
+	app, err := app.Prepare(generate.Fields())
+	if err != nil {
+		return nil, err
+	}
+
+	sink := options.Sink
+	if sink == nil {
+		sink = Files{}
+	}
+
+	src := options.Source
+	if src == nil {
+		if options.Workbook == nil {
+			return nil, errors.New("xlsx2html: neither Workbook nor Source is set")
+		}
+
+		read, err := ReadSource(options.Workbook)
+		if err != nil {
+			return nil, err
+		}
+		defer read.Close()
+
+		src = read
+	}
+
+	if _, ok := sink.(Files); ok {
+		sink = newGuard(sink, src)
+	}
+
+	selected, err := selectDatasets(app.Excel.Dataset, options.Datasets)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := src.(*source.Stream); ok && len(selected) != 1 {
+		return nil, fmt.Errorf("xlsx2html: a CSV, TSV or JSON text has a single sheet, select exactly one dataset instead of %d", len(selected))
+	}
+
+	results := &Results{}
+	sheets := make([][][]string, len(selected))
+
+	for i, dataset := range selected {
+		rows, err := src.Rows(dataset.Sheet, app.Excel.Skip.Row)
+		if errors.Is(err, ErrSheetNotFound) {
+			results.Warnings = append(results.Warnings, err.Error())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("%s: header row not found after skipping %d row(s) of Excel.skip.row", dataset.Sheet, app.Excel.Skip.Row)
+		}
+
+		sheets[i] = rows
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	datasets := make([]*Result, len(selected))
+
+	for i, dataset := range selected {
+		if sheets[i] == nil {
+			continue
+		}
+
+		setting := generate.Setting{
+			Sheet:      dataset.Sheet,
+			Rarity:     dataset.Rarity,
+			Icon:       dataset.Icon,
+			Output:     filepath.Join(options.Output, dataset.Output),
+			Collection: dataset.Collection,
+			Title:      dataset.Title,
+			Sink:       sink,
+		}
+		if len(setting.Title) == 0 {
+			setting.Title = dataset.Sheet
+		}
+		index, rows := i, sheets[i]
+
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			result, err := generate.Start(
+				&setting,
+				&app.Excel,
+				&app.Html,
+				&rows,
+			)
+			datasets[index] = result
+
+			return err
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range datasets {
+		if result != nil {
+			results.Datasets = append(results.Datasets, result)
+		}
+	}
+
+	if len(app.Html.Index) > 0 {
+		results.Index = filepath.Join(options.Output, app.Html.Index)
+
+		err = generate.Index(&app.Html, sink, results.Index, results.Datasets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func selectDatasets(datasets []application.Dataset, sheets []string) ([]application.Dataset, error) {
+	if len(sheets) == 0 {
+		return datasets, nil
+	}
+
+	var selected []application.Dataset
+
+	for _, sheet := range sheets {
+		found := false
+
+		for _, dataset := range datasets {
+			if dataset.Sheet == sheet {
+				selected = append(selected, dataset)
+				found = true
+			}
+		}
+
+		if !found {
+			names := make([]string, len(datasets))
+			for i, dataset := range datasets {
+				names[i] = dataset.Sheet
+			}
+
+			return nil, fmt.Errorf("no dataset for sheet %q, must be one of %s", sheet, strings.Join(names, ", "))
+		}
+	}
+
+	return selected, nil
+}
+
+type guard struct {
+	sink   Sink
+	inputs map[string]bool
+}
+
+func newGuard(sink Sink, src Source) Sink {
+	inputs, ok := src.(interface{ Inputs() []string })
+	if !ok {
+		return sink
+	}
+
+	g := guard{sink: sink, inputs: map[string]bool{}}
+
+	for _, path := range inputs.Inputs() {
+		if abs, err := filepath.Abs(path); err == nil {
+			g.inputs[abs] = true
+		}
+	}
+
+	return g
+}
+
+func (g guard) Write(output string, text string) error {
+	if abs, err := filepath.Abs(output); err == nil && g.inputs[abs] {
+		return fmt.Errorf("%s: refusing to overwrite an input file, change --output or the output of the dataset", output)
+	}
+
+	return g.sink.Write(output, text)
+}
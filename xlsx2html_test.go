@@ -0,0 +1,184 @@
+package xlsx2html
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Angelmaneuver/xlsx2html/internal/kamipro/source"
+	"github.com/xuri/excelize/v2"
+)
+
+const sheet = "神姫リスト\n-\n-\nNo,神姫名,神姫名 (ひらがな),属性,タイプ,HP1,Attack1\n1,ゼウス,ぜうす,光,Balance,2100,1000\n"
+
+func TestGenerateRarity(t *testing.T) {
+	app, err := LoadApplication("", []string{
+		`Excel.dataset=[{ sheet = "UR神姫リスト", rarity = "UR", icon = "UR%03d", output = "UR神姫リスト.html" }]`,
+		`Html.Threshold.ur={ hp = [{ min = 2000, format = "higher" }, { min = 0 }], attack = [{ min = 0, format = "lower" }] }`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &Buffer{}
+
+	results, err := Generate(context.Background(), Options{Workbook: strings.NewReader(sheet), Application: app, Sink: buffer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Datasets) != 1 || results.Datasets[0].Rarity != "UR" || results.Datasets[0].Count != 1 {
+		t.Fatalf("got %+v, want one UR dataset", results.Datasets)
+	}
+
+	files := buffer.Files()
+	if len(files) != 1 || files[0].Output != "UR神姫リスト.html" {
+		t.Fatalf("got %v, want UR神姫リスト.html", files)
+	}
+
+	for _, want := range []string{`<span class="higher">2100</span>`, `<span class="lower">1000</span>`} {
+		if !strings.Contains(files[0].Text, want) {
+			t.Errorf("%s not found in %s", want, files[0].Text)
+		}
+	}
+}
+
+func TestGenerateWithoutThreshold(t *testing.T) {
+	app, err := LoadApplication("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app.Html.Threshold = nil
+
+	_, err = Generate(context.Background(), Options{Workbook: strings.NewReader(sheet), Application: app, Sink: &Buffer{}})
+
+	var result Problems
+	if !errors.As(err, &result) {
+		t.Fatalf("got %v, want Problems", err)
+	}
+
+	for _, problem := range result {
+		if problem.Path != "Html.Threshold" {
+			t.Errorf("%s: %s reported for a blank Html.Threshold", problem.Path, problem.Message)
+		}
+	}
+}
+
+func TestGuard(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "SSR.csv")
+
+	err := os.WriteFile(input, []byte("No\n1\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := OpenSource(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+
+	buffer := &Buffer{}
+	sink := newGuard(buffer, src)
+
+	err = sink.Write(filepath.Join(dir, ".", "SSR.csv"), "overwritten")
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite an input file") {
+		t.Errorf("got %v, want a refusal", err)
+	}
+
+	err = sink.Write(filepath.Join(dir, "SSR.html"), "html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if files := buffer.Files(); len(files) != 1 || files[0].Output != filepath.Join(dir, "SSR.html") {
+		t.Errorf("got %v, want SSR.html only", files)
+	}
+
+	text, _ := os.ReadFile(input)
+	if string(text) != "No\n1\n" {
+		t.Errorf("input changed to %q", text)
+	}
+
+	if _, ok := newGuard(buffer, &source.Stream{}).(*Buffer); !ok {
+		t.Error("a source without inputs should not be guarded")
+	}
+}
+
+func TestGenerateMissingSheet(t *testing.T) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	buffer, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Generate(context.Background(), Options{Workbook: buffer, Sink: &Buffer{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Datasets) != 0 || len(results.Warnings) != 9 {
+		t.Errorf("got %d dataset(s) and warnings %v, want a warning for each of the 9 sheets", len(results.Datasets), results.Warnings)
+	}
+}
+
+func TestGenerateStream(t *testing.T) {
+	app, err := LoadApplication("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, datasets := range [][]string{nil, {"SSR神姫リスト", "SR神姫リスト"}} {
+		buffer := &Buffer{}
+
+		_, err = Generate(context.Background(), Options{Workbook: strings.NewReader(sheet), Application: app, Sink: buffer, Datasets: datasets})
+		if err == nil || !strings.Contains(err.Error(), "select exactly one dataset") {
+			t.Errorf("%v: got %v, want an error", datasets, err)
+		}
+
+		if len(buffer.Files()) > 0 {
+			t.Errorf("%v: got %d output(s), want none", datasets, len(buffer.Files()))
+		}
+	}
+
+	results, err := Generate(context.Background(), Options{Workbook: strings.NewReader(sheet), Application: app, Sink: &Buffer{}, Datasets: []string{"SR神姫リスト"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Datasets) != 1 || results.Datasets[0].Rarity != "SR" {
+		t.Errorf("got %+v, want the SR dataset", results.Datasets)
+	}
+}
+
+func TestGenerateMissingColumn(t *testing.T) {
+	app, err := LoadApplication("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	columns := map[string][]string{}
+	for field, aliases := range app.Excel.Columns {
+		columns[field] = aliases
+	}
+	delete(columns, "Attribute")
+
+	app.Excel.Columns = columns
+	app.Excel.Forms = []Form{{Name: "Normal"}}
+	app.Excel.Sort = []Sort{{Name: "No", Ascending: true}}
+	app.Html.Threshold = Thresholds{"SR": Threshold{Hp: Tiers{{Min: 0}}, Attack: Tiers{{Min: 0}}}, "SSR": Threshold{}, "R": Threshold{}, "Skin": Threshold{}}
+
+	_, err = Generate(context.Background(), Options{Workbook: strings.NewReader(sheet), Application: app, Sink: &Buffer{}, Datasets: []string{"SR神姫リスト"}})
+
+	var result Problems
+	if !errors.As(err, &result) || len(result) != 1 || result[0].Path != "Excel.Columns.Attribute" {
+		t.Errorf("got %v, want a problem for Excel.Columns.Attribute", err)
+	}
+}